@@ -0,0 +1,198 @@
+package polymarket
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// L2 authentication headers
+const (
+	headerPolyAddress    = "POLY_ADDRESS"
+	headerPolySignature  = "POLY_SIGNATURE"
+	headerPolyTimestamp  = "POLY_TIMESTAMP"
+	headerPolyAPIKey     = "POLY_API_KEY"
+	headerPolyPassphrase = "POLY_PASSPHRASE"
+)
+
+// APICredentials are the CLOB API key credentials used for L2 authentication
+type APICredentials struct {
+	Key        string `json:"apiKey"`
+	Secret     string `json:"secret"` // URL-safe base64
+	Passphrase string `json:"passphrase"`
+}
+
+// SignL2Request computes the L2 HMAC signature of a CLOB request: the
+// URL-safe base64 HMAC-SHA256, keyed by the decoded secret, of
+// timestamp + method + requestPath + body. requestPath excludes the query string.
+func SignL2Request(secret string, timestamp int64, method, requestPath string, body []byte) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", fmt.Errorf("invalid API secret: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte(method))
+	mac.Write([]byte(requestPath))
+	mac.Write(body)
+
+	return base64.URLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// L2Headers returns the authentication headers of a CLOB request made at timestamp (Unix seconds)
+func L2Headers(address string, creds APICredentials, timestamp int64, method, requestPath string, body []byte) (http.Header, error) {
+	signature, err := SignL2Request(creds.Secret, timestamp, method, requestPath, body)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set(headerPolyAddress, address)
+	header.Set(headerPolySignature, signature)
+	header.Set(headerPolyTimestamp, strconv.FormatInt(timestamp, 10))
+	header.Set(headerPolyAPIKey, creds.Key)
+	header.Set(headerPolyPassphrase, creds.Passphrase)
+	return header, nil
+}
+
+// decodeSecret decodes an API secret, accepting URL-safe or standard base64
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := base64.URLEncoding.DecodeString(secret)
+	if err == nil {
+		return key, nil
+	}
+	if key, stdErr := base64.StdEncoding.DecodeString(secret); stdErr == nil {
+		return key, nil
+	}
+	return nil, err
+}
+
+// L2Transport is an http.RoundTripper that signs requests with L2 API key
+// authentication before passing them to Base
+type L2Transport struct {
+	// Base is the underlying transport; nil uses http.DefaultTransport
+	Base http.RoundTripper
+
+	// Address is the wallet address the API key belongs to
+	Address     string
+	Credentials APICredentials
+
+	// Host restricts signing to requests for this host; empty signs every request
+	Host string
+
+	// Now returns the current time; nil uses time.Now
+	Now func() time.Time
+}
+
+// RoundTrip signs the request and sends it with the base transport
+func (t *L2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if t.Host != "" && !strings.EqualFold(req.URL.Host, t.Host) {
+		return base.RoundTrip(req)
+	}
+
+	signed := req.Clone(req.Context())
+	body, err := readRequestBody(req, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now
+	if t.Now != nil {
+		now = t.Now
+	}
+	header, err := L2Headers(t.Address, t.Credentials, now().Unix(), req.Method, req.URL.Path, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		signed.Header[key] = values
+	}
+
+	return base.RoundTrip(signed)
+}
+
+// readRequestBody returns the body of req, leaving a readable copy on clone
+func readRequestBody(req, clone *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	rc := req.Body
+	if req.GetBody != nil {
+		var err error
+		if rc, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	defer rc.Close()
+
+	body, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+	clone.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return body, nil
+}
+
+// installL2Auth wraps the HTTP transport so that CLOB requests are signed
+func (c *Client) installL2Auth() {
+	host := ""
+	if u, err := url.Parse(c.clobBaseURL); err == nil {
+		host = u.Host
+	}
+
+	httpClient := c.cloneHTTPClient()
+	httpClient.Transport = &L2Transport{
+		Base:        httpClient.Transport,
+		Address:     c.address,
+		Credentials: *c.credentials,
+		Host:        host,
+	}
+}
+
+// requireCredentials returns ErrNoCredentials if the client has no API credentials
+func (c *Client) requireCredentials() error {
+	if c.credentials == nil {
+		return ErrNoCredentials
+	}
+	return nil
+}
+
+// GetAPIKeys retrieves the API keys of the authenticated wallet
+func (c *Client) GetAPIKeys() ([]string, error) {
+	return c.GetAPIKeysContext(context.Background())
+}
+
+// GetAPIKeysContext is like GetAPIKeys but uses ctx for cancellation and deadlines
+func (c *Client) GetAPIKeysContext(ctx context.Context) ([]string, error) {
+	if err := c.requireCredentials(); err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		APIKeys []string `json:"apiKeys"`
+	}
+	if err := c.getCLOB(ctx, "/auth/api-keys", nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to fetch API keys: %w", err)
+	}
+
+	return response.APIKeys, nil
+}
@@ -0,0 +1,173 @@
+package polymarket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testSecret      = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+	testOtherSecret = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+)
+
+func TestSignL2Request(t *testing.T) {
+	// The first vector is the HMAC test case of the reference Python client
+	// (py-clob-client); the others were computed with Python's hmac module
+	// using the same message layout.
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		method    string
+		path      string
+		body      string
+		want      string
+	}{
+		{"reference json body", testSecret, 1000000, "test-sign", "/orders", `{"hash": "0x123"}`, "ZwAdJKvoYRlEKDkNMwd5BuwNNtg93kNaR_oU2HrfVvc="},
+		{"no body", testSecret, 1000000, "GET", "/data/orders", "", "9-I3DmVY9ObJ6EVf_KvrHpUWMOQJXiAjR5z7fO8qnPw="},
+		{"json body", testOtherSecret, 1700000000, "DELETE", "/order", `{"orderID":"0xabc"}`, "R6Zjd0XmcT575-SA5KWD9BUc-qkFqvudAxkHd3R1vpw="},
+		{"no body other secret", testOtherSecret, 1700000000, "GET", "/auth/api-keys", "", "LfmqzW1z83savtVsjHKaeJilx7FGGilNTZOhdE2W8Vc="},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			if tt.body != "" {
+				body = []byte(tt.body)
+			}
+			got, err := SignL2Request(tt.secret, tt.timestamp, tt.method, tt.path, body)
+			if err != nil {
+				t.Fatalf("SignL2Request: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("SignL2Request = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := SignL2Request("not base64!", 1, "GET", "/", nil); err == nil {
+		t.Error("SignL2Request with an invalid secret succeeded")
+	}
+}
+
+// recordedRequest is what a test server saw of a request
+type recordedRequest struct {
+	path   string
+	query  string
+	header http.Header
+	body   string
+}
+
+// recordingServer records every request it receives and answers with "[]"
+func recordingServer(t *testing.T) (*httptest.Server, <-chan recordedRequest) {
+	t.Helper()
+
+	requests := make(chan recordedRequest, 16)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- recordedRequest{r.URL.Path, r.URL.RawQuery, r.Header.Clone(), string(body)}
+		w.Write([]byte("[]"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+func TestL2TransportSignsPathWithoutQuery(t *testing.T) {
+	srv, requests := recordingServer(t)
+	u, _ := url.Parse(srv.URL)
+
+	client := &http.Client{Transport: &L2Transport{
+		Address:     "0xabc",
+		Credentials: APICredentials{Key: "key", Secret: testSecret, Passphrase: "pass"},
+		Host:        u.Host,
+		Now:         func() time.Time { return time.Unix(1000000, 0) },
+	}}
+
+	resp, err := client.Get(srv.URL + "/data/orders?market=0x1&next_cursor=MA%3D%3D")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	resp.Body.Close()
+
+	got := <-requests
+	if got.query != "market=0x1&next_cursor=MA%3D%3D" {
+		t.Errorf("query = %s, want it passed through unchanged", got.query)
+	}
+	want := map[string]string{
+		"POLY_ADDRESS":    "0xabc",
+		"POLY_SIGNATURE":  "9-I3DmVY9ObJ6EVf_KvrHpUWMOQJXiAjR5z7fO8qnPw=",
+		"POLY_TIMESTAMP":  "1000000",
+		"POLY_API_KEY":    "key",
+		"POLY_PASSPHRASE": "pass",
+	}
+	for name, value := range want {
+		if header := got.header.Get(name); header != value {
+			t.Errorf("%s = %q, want %q", name, header, value)
+		}
+	}
+}
+
+func TestL2TransportSignsBody(t *testing.T) {
+	srv, requests := recordingServer(t)
+
+	client := &http.Client{Transport: &L2Transport{
+		Credentials: APICredentials{Secret: testOtherSecret},
+		Now:         func() time.Time { return time.Unix(1700000000, 0) },
+	}}
+
+	req, _ := http.NewRequest("DELETE", srv.URL+"/order", strings.NewReader(`{"orderID":"0xabc"}`))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	resp.Body.Close()
+
+	got := <-requests
+	if got.body != `{"orderID":"0xabc"}` {
+		t.Errorf("body = %q, want it forwarded after signing", got.body)
+	}
+	if sig := got.header.Get("POLY_SIGNATURE"); sig != "R6Zjd0XmcT575-SA5KWD9BUc-qkFqvudAxkHd3R1vpw=" {
+		t.Errorf("POLY_SIGNATURE = %s", sig)
+	}
+}
+
+func TestL2AuthOnlySignsCLOBRequests(t *testing.T) {
+	gamma, gammaRequests := recordingServer(t)
+	clob, clobRequests := recordingServer(t)
+
+	creds := APICredentials{Key: "key", Secret: testSecret, Passphrase: "pass"}
+	c := NewClient(WithBaseURL(gamma.URL), WithCLOBBaseURL(clob.URL), WithL2Auth("0xabc", creds))
+
+	if _, err := c.makeRequest(context.Background(), "GET", "/markets", url.Values{"limit": {"1"}}); err != nil {
+		t.Fatalf("gamma request: %v", err)
+	}
+	got := <-gammaRequests
+	for _, name := range []string{"POLY_ADDRESS", "POLY_SIGNATURE", "POLY_TIMESTAMP", "POLY_API_KEY", "POLY_PASSPHRASE"} {
+		if value := got.header.Get(name); value != "" {
+			t.Errorf("gamma request has %s = %q", name, value)
+		}
+	}
+
+	var v []interface{}
+	if err := c.getCLOB(context.Background(), "/data/trades", url.Values{"market": {"0x1"}}, &v); err != nil {
+		t.Fatalf("CLOB request: %v", err)
+	}
+	got = <-clobRequests
+	timestamp, err := strconv.ParseInt(got.header.Get("POLY_TIMESTAMP"), 10, 64)
+	if err != nil {
+		t.Fatalf("POLY_TIMESTAMP = %q", got.header.Get("POLY_TIMESTAMP"))
+	}
+	want, _ := SignL2Request(creds.Secret, timestamp, "GET", "/data/trades", nil)
+	if sig := got.header.Get("POLY_SIGNATURE"); sig != want {
+		t.Errorf("POLY_SIGNATURE = %s, want %s (path without query)", sig, want)
+	}
+	if got.header.Get("POLY_API_KEY") != "key" || got.header.Get("POLY_ADDRESS") != "0xabc" {
+		t.Errorf("CLOB request headers = %v", got.header)
+	}
+}
@@ -0,0 +1,260 @@
+package polymarket
+
+import (
+	"context"
+	"math"
+	"sync"
+)
+
+// defaultFetchWorkers is the number of concurrent page fetches used by FetchAll helpers
+const defaultFetchWorkers = 4
+
+// FetchAllOptions controls a parallel bulk fetch
+type FetchAllOptions struct {
+	// Workers is the number of pages fetched concurrently (default 4)
+	Workers int
+
+	// MaxItems caps the number of items returned. Pages are fetched until
+	// MaxItems distinct items are known or the results run out, so
+	// duplicates across pages don't reduce the count. Zero means no cap.
+	MaxItems int
+
+	// Progress, if set, is called after every page with the running totals.
+	// Calls are serialized.
+	Progress func(FetchProgress)
+}
+
+// FetchProgress reports the state of a bulk fetch
+type FetchProgress struct {
+	Pages int // Pages fetched so far
+	Items int // Items fetched so far, before de-duplication
+}
+
+// FetchAllMarkets fetches every market matching params by fetching offset
+// pages of params.Limit markets concurrently. Results keep the order
+// requested by params.Order and are de-duplicated by ID. Requests go through
+// the client's rate limiter and retry policy.
+func (c *Client) FetchAllMarkets(ctx context.Context, params *MarketsParams, opts *FetchAllOptions) ([]Market, error) {
+	p := MarketsParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.Limit <= 0 {
+		p.Limit = defaultPageSize
+	}
+	start := p.Offset
+
+	fetchPage := func(ctx context.Context, page int) ([]Market, error) {
+		pageParams := p
+		pageParams.Offset = start + page*p.Limit
+		return c.GetMarketsContext(ctx, &pageParams)
+	}
+
+	return fetchAll(ctx, p.Limit, opts, fetchPage, func(m Market) string { return m.ID })
+}
+
+// FetchAllEvents fetches every event matching params by fetching offset
+// pages of params.Limit events concurrently. Results keep the order
+// requested by params.Order and are de-duplicated by ID. Requests go through
+// the client's rate limiter and retry policy.
+func (c *Client) FetchAllEvents(ctx context.Context, params *EventsParams, opts *FetchAllOptions) ([]Event, error) {
+	p := EventsParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.Limit <= 0 {
+		p.Limit = defaultPageSize
+	}
+	start := p.Offset
+
+	fetchPage := func(ctx context.Context, page int) ([]Event, error) {
+		pageParams := p
+		pageParams.Offset = start + page*p.Limit
+		return c.GetEventsContext(ctx, &pageParams)
+	}
+
+	return fetchAll(ctx, p.Limit, opts, fetchPage, func(e Event) string { return e.ID })
+}
+
+// fetchAll fetches zero-based pages across a pool of workers until a page
+// comes back short, then stitches the pages together in order. Pages past
+// the short page are fetched speculatively and their errors are ignored.
+// With MaxItems, pages are fetched until that many distinct items are known.
+func fetchAll[T any](ctx context.Context, pageSize int, opts *FetchAllOptions, fetchPage func(ctx context.Context, page int) ([]T, error), id func(T) string) ([]T, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts == nil {
+		opts = &FetchAllOptions{}
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = defaultFetchWorkers
+	}
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	f := &fetchState[T]{
+		pageSize: pageSize,
+		maxItems: opts.MaxItems,
+		progress: opts.Progress,
+		id:       id,
+		cancel:   cancel,
+		pages:    make(map[int64][]T),
+		seen:     make(map[string]bool),
+		lastPage: math.MaxInt64,
+		errPage:  math.MaxInt64,
+		wanted:   math.MaxInt64,
+	}
+	f.cond = sync.NewCond(&f.mu)
+	if f.maxItems > 0 {
+		// Don't dispatch pages past the item cap until duplicates call for more
+		f.wanted = int64((f.maxItems + pageSize - 1) / pageSize)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				page, ok := f.claim(ctx)
+				if !ok {
+					return
+				}
+				items, err := fetchPage(ctx, int(page))
+				f.complete(page, items, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := parent.Err(); err != nil {
+		return nil, err
+	}
+	if f.fatal != nil {
+		return nil, f.fatal
+	}
+	if !f.satisfied && f.errPage <= f.lastPage {
+		return nil, f.errValue
+	}
+
+	results := f.results
+	if f.maxItems > 0 && len(results) > f.maxItems {
+		results = results[:f.maxItems]
+	}
+	return results, nil
+}
+
+// fetchState is the shared state of a fetchAll run, guarded by mu
+type fetchState[T any] struct {
+	pageSize int
+	maxItems int
+	progress func(FetchProgress)
+	id       func(T) string
+	cancel   context.CancelFunc
+
+	mu       sync.Mutex
+	cond     *sync.Cond
+	next     int64 // next page to dispatch
+	inFlight int
+
+	pages    map[int64][]T // fetched pages not yet stitched
+	stitched int64         // pages 0 to stitched-1 are in results
+	results  []T
+	seen     map[string]bool
+	counts   FetchProgress
+
+	lastPage  int64 // first page that came back short
+	wanted    int64 // pages to dispatch to reach maxItems
+	satisfied bool  // maxItems distinct items are known
+
+	errPage  int64 // lowest page that failed
+	errValue error
+	fatal    error // error of a page before the last page
+}
+
+// claim returns the next page to fetch, waiting while pages in flight may
+// still require more. It reports false when no more pages are needed.
+func (f *fetchState[T]) claim(ctx context.Context) (int64, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for {
+		if f.fatal != nil || f.satisfied || ctx.Err() != nil {
+			return 0, false
+		}
+		if f.next <= f.lastPage && f.next < f.errPage && f.next < f.wanted {
+			page := f.next
+			f.next++
+			f.inFlight++
+			return page, true
+		}
+		if f.inFlight == 0 {
+			return 0, false
+		}
+		f.cond.Wait()
+	}
+}
+
+// complete records the outcome of a page fetch and updates the plan
+func (f *fetchState[T]) complete(page int64, items []T, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	defer f.cond.Broadcast()
+
+	f.inFlight--
+	if err != nil {
+		if page < f.errPage {
+			f.errPage, f.errValue = page, err
+		}
+	} else {
+		f.pages[page] = items
+		if len(items) < f.pageSize && page < f.lastPage {
+			f.lastPage = page
+		}
+		f.counts.Pages++
+		f.counts.Items += len(items)
+		if f.progress != nil {
+			f.progress(f.counts)
+		}
+	}
+
+	// Stitch the pages that are now contiguous, in order
+	for f.stitched <= f.lastPage {
+		items, ok := f.pages[f.stitched]
+		if !ok {
+			break
+		}
+		delete(f.pages, f.stitched)
+		f.stitched++
+		for _, item := range items {
+			key := f.id(item)
+			if f.seen[key] {
+				continue
+			}
+			f.seen[key] = true
+			f.results = append(f.results, item)
+		}
+	}
+
+	if f.maxItems > 0 {
+		if len(f.results) >= f.maxItems {
+			f.satisfied = true
+			f.cancel() // the remaining pages are not needed
+			return
+		}
+		needed := int64((f.maxItems - len(f.results) + f.pageSize - 1) / f.pageSize)
+		if f.stitched+needed > f.wanted {
+			f.wanted = f.stitched + needed
+		}
+	}
+
+	// An error is fatal once every page before it is known to be full
+	if f.errPage <= f.lastPage && f.stitched >= f.errPage && f.fatal == nil {
+		f.fatal = f.errValue
+		f.cancel()
+	}
+}
@@ -0,0 +1,179 @@
+package polymarket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetchAllKeepsPageOrderAcrossWorkers(t *testing.T) {
+	serve := sequentialIDs(95)
+	srv, _ := marketPages(t, func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		// Later pages answer first so that workers finish out of order
+		time.Sleep(time.Duration(100-offset) * 200 * time.Microsecond)
+		return serve(w, r, offset, limit)
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	var progress []FetchProgress
+	markets, err := c.FetchAllMarkets(context.Background(), &MarketsParams{Limit: 10}, &FetchAllOptions{
+		Workers:  4,
+		Progress: func(p FetchProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("FetchAllMarkets: %v", err)
+	}
+	checkSequentialIDs(t, marketIDs(markets), 95)
+
+	if len(progress) < 10 {
+		t.Fatalf("got %d progress reports, want at least 10", len(progress))
+	}
+	for i, p := range progress {
+		if p.Pages != i+1 {
+			t.Errorf("progress %d reports %d pages", i, p.Pages)
+		}
+	}
+}
+
+func TestFetchAllStopsAtFirstShortPage(t *testing.T) {
+	// Page 1 is short; pages after it are full but must be ignored
+	srv, _ := marketPages(t, func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		ids := sequentialIDs(1000)(w, r, offset, limit)
+		if offset == 10 {
+			ids = ids[:7]
+		}
+		return ids
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	markets, err := c.FetchAllMarkets(context.Background(), &MarketsParams{Limit: 10}, &FetchAllOptions{Workers: 4})
+	if err != nil {
+		t.Fatalf("FetchAllMarkets: %v", err)
+	}
+	checkSequentialIDs(t, marketIDs(markets), 17)
+}
+
+func TestFetchAllDeduplicatesOverlappingPages(t *testing.T) {
+	// Each page repeats the last two items of the previous one, as happens
+	// when new items are inserted at the top while paging
+	srv, _ := marketPages(t, func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		start := offset - 2*(offset/limit)
+		ids := []string{}
+		for i := start; i < start+limit && i < 34; i++ {
+			ids = append(ids, strconv.Itoa(i))
+		}
+		return ids
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	markets, err := c.FetchAllMarkets(context.Background(), &MarketsParams{Limit: 10}, &FetchAllOptions{Workers: 3})
+	if err != nil {
+		t.Fatalf("FetchAllMarkets: %v", err)
+	}
+	checkSequentialIDs(t, marketIDs(markets), 34)
+}
+
+func TestFetchAllErrorCancelsOtherPages(t *testing.T) {
+	srv, _ := marketPages(t, func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		switch offset {
+		case 0:
+			return sequentialIDs(1000)(w, r, offset, limit)
+		case 10:
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		// Later pages hang until the client gives up on them
+		select {
+		case <-r.Context().Done():
+		case <-time.After(10 * time.Second):
+		}
+		return nil
+	})
+	c := NewClient(WithBaseURL(srv.URL), WithRetryPolicy(fastRetryPolicy(1)))
+
+	start := time.Now()
+	_, err := c.FetchAllMarkets(context.Background(), &MarketsParams{Limit: 10}, &FetchAllOptions{Workers: 4})
+	if !errors.Is(err, ErrServer) {
+		t.Fatalf("error = %v, want ErrServer", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("FetchAllMarkets returned after %v; other pages were not cancelled", elapsed)
+	}
+}
+
+func TestFetchAllMaxItems(t *testing.T) {
+	srv, requests := marketPages(t, sequentialIDs(1000))
+	c := NewClient(WithBaseURL(srv.URL))
+
+	markets, err := c.FetchAllMarkets(context.Background(), &MarketsParams{Limit: 10}, &FetchAllOptions{Workers: 4, MaxItems: 25})
+	if err != nil {
+		t.Fatalf("FetchAllMarkets: %v", err)
+	}
+	checkSequentialIDs(t, marketIDs(markets), 25)
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+}
+
+func TestFetchAllIgnoresErrorsPastLastPage(t *testing.T) {
+	// Page 2 is short; the pages fetched speculatively after it fail
+	srv, _ := marketPages(t, func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		if offset > 20 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		if offset == 0 {
+			// Let the speculative pages fail before the short page is seen
+			time.Sleep(20 * time.Millisecond)
+		}
+		return sequentialIDs(25)(w, r, offset, limit)
+	})
+	c := NewClient(WithBaseURL(srv.URL), WithRetryPolicy(fastRetryPolicy(1)))
+
+	markets, err := c.FetchAllMarkets(context.Background(), &MarketsParams{Limit: 10}, &FetchAllOptions{Workers: 6})
+	if err != nil {
+		t.Fatalf("FetchAllMarkets: %v", err)
+	}
+	checkSequentialIDs(t, marketIDs(markets), 25)
+}
+
+func TestFetchAllErrorBeforeShortPage(t *testing.T) {
+	// Page 1 fails and page 2 is short, so page 1 is a real page
+	srv, _ := marketPages(t, func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		if offset == 10 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		return sequentialIDs(25)(w, r, offset, limit)
+	})
+	c := NewClient(WithBaseURL(srv.URL), WithRetryPolicy(fastRetryPolicy(1)))
+
+	_, err := c.FetchAllMarkets(context.Background(), &MarketsParams{Limit: 10}, &FetchAllOptions{Workers: 1})
+	if !errors.Is(err, ErrServer) {
+		t.Fatalf("error = %v, want ErrServer", err)
+	}
+}
+
+func TestFetchAllMaxItemsWithOverlappingPages(t *testing.T) {
+	// Each page repeats the last five items of the previous one, so three
+	// pages of ten hold only twenty distinct items
+	srv, _ := marketPages(t, func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		start := offset - 5*(offset/limit)
+		ids := []string{}
+		for i := start; i < start+limit && i < 100; i++ {
+			ids = append(ids, strconv.Itoa(i))
+		}
+		return ids
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	markets, err := c.FetchAllMarkets(context.Background(), &MarketsParams{Limit: 10}, &FetchAllOptions{Workers: 4, MaxItems: 25})
+	if err != nil {
+		t.Fatalf("FetchAllMarkets: %v", err)
+	}
+	checkSequentialIDs(t, marketIDs(markets), 25)
+}
@@ -0,0 +1,94 @@
+package polymarket
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// lruCache is a fixed-size least-recently-used cache safe for concurrent use.
+// Entries older than ttl are treated as missing; a zero ttl keeps them until
+// they are evicted.
+type lruCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	order    *list.List // front is most recently used
+	items    map[K]*list.Element
+}
+
+// lruEntry is the value stored in the cache's list elements
+type lruEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time // zero if the entry does not expire
+}
+
+// newLRUCache creates a cache holding up to capacity entries for ttl each
+func newLRUCache[K comparable, V any](capacity int, ttl time.Duration) *lruCache[K, V] {
+	return &lruCache[K, V]{
+		capacity: capacity,
+		ttl:      ttl,
+		now:      time.Now,
+		order:    list.New(),
+		items:    make(map[K]*list.Element),
+	}
+}
+
+// Get returns the cached value for key and marks it as recently used.
+// Expired entries are removed and reported as missing.
+func (c *lruCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	elem, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+
+	entry := elem.Value.(*lruEntry[K, V])
+	if !entry.expires.IsZero() && !c.now().Before(entry.expires) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		return zero, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Add stores value under key, evicting the least recently used entry if full
+func (c *lruCache[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expires time.Time
+	if c.ttl > 0 {
+		expires = c.now().Add(c.ttl)
+	}
+
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*lruEntry[K, V])
+		entry.value, entry.expires = value, expires
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, expires: expires})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+// Purge removes all entries
+func (c *lruCache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.items = make(map[K]*list.Element)
+}
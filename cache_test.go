@@ -0,0 +1,158 @@
+package polymarket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newLRUCache[string, int](2, 0)
+	cache.Add("a", 1)
+	cache.Add("b", 2)
+	cache.Get("a") // b is now the least recently used
+	cache.Add("c", 3)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("b was not evicted")
+	}
+	for key, want := range map[string]int{"a": 1, "c": 3} {
+		if got, ok := cache.Get(key); !ok || got != want {
+			t.Errorf("Get(%s) = %d, %v; want %d, true", key, got, ok, want)
+		}
+	}
+
+	cache.Add("a", 10)
+	cache.Add("d", 4) // c is the least recently used after updating a
+	if got, ok := cache.Get("a"); !ok || got != 10 {
+		t.Errorf("Get(a) = %d, %v; want 10, true", got, ok)
+	}
+	if _, ok := cache.Get("c"); ok {
+		t.Error("c was not evicted")
+	}
+}
+
+func TestLRUCacheTTL(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	cache := newLRUCache[string, int](2, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Add("a", 1)
+	now = now.Add(30 * time.Second)
+	cache.Add("b", 2)
+
+	now = now.Add(30 * time.Second)
+	if _, ok := cache.Get("a"); ok {
+		t.Error("a is still cached after its TTL")
+	}
+	if got, ok := cache.Get("b"); !ok || got != 2 {
+		t.Errorf("Get(b) = %d, %v; want 2, true", got, ok)
+	}
+
+	// Adding again restarts the TTL
+	cache.Add("b", 3)
+	now = now.Add(59 * time.Second)
+	if got, ok := cache.Get("b"); !ok || got != 3 {
+		t.Errorf("Get(b) after re-adding = %d, %v; want 3, true", got, ok)
+	}
+
+	cache.Purge()
+	if _, ok := cache.Get("b"); ok {
+		t.Error("b is still cached after Purge")
+	}
+}
+
+// profileServer serves /public-profile and counts the requests it receives
+func profileServer(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path != "/public-profile" {
+			t.Errorf("path = %s, want /public-profile", r.URL.Path)
+		}
+		w.Write([]byte(`{"proxyWallet":"` + r.URL.Query().Get("address") + `","name":"alice",
+			"createdAt":"2024-01-02T03:04:05Z","users":[{"id":"1","creator":true}]}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestGetProfileCachesByLowercaseAddress(t *testing.T) {
+	srv, requests := profileServer(t)
+	c := NewClient(WithBaseURL(srv.URL))
+
+	for _, address := range []string{testAddress, "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", "0XF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266"} {
+		profile, err := c.GetProfileContext(context.Background(), address)
+		if err != nil {
+			t.Fatalf("GetProfile(%s): %v", address, err)
+		}
+		if profile.ProxyWallet != testAddress {
+			t.Errorf("ProxyWallet = %s, want %s", profile.ProxyWallet, testAddress)
+		}
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+
+	c.ClearProfileCache()
+	if _, err := c.GetProfileContext(context.Background(), testAddress); err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("requests after ClearProfileCache = %d, want 2", got)
+	}
+}
+
+func TestGetProfileReturnsCopies(t *testing.T) {
+	srv, requests := profileServer(t)
+	c := NewClient(WithBaseURL(srv.URL))
+
+	first, err := c.GetProfileContext(context.Background(), testAddress)
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	first.Name = "mallory"
+	first.Users[0].ID = "changed"
+	*first.CreatedAt = time.Time{}
+
+	second, err := c.GetProfileContext(context.Background(), testAddress)
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	second.Users = append(second.Users[:0], ProfileUser{ID: "appended"})
+
+	third, err := c.GetProfileContext(context.Background(), testAddress)
+	if err != nil {
+		t.Fatalf("GetProfile: %v", err)
+	}
+	if third.Name != "alice" || len(third.Users) != 1 || third.Users[0].ID != "1" || third.CreatedAt.Year() != 2024 {
+		t.Errorf("cached profile = %+v, modified through a returned copy", third)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestGetProfileCacheOptions(t *testing.T) {
+	srv, requests := profileServer(t)
+
+	c := NewClient(WithBaseURL(srv.URL), WithProfileCacheSize(0))
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetProfileContext(context.Background(), testAddress); err != nil {
+			t.Fatalf("GetProfile: %v", err)
+		}
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("requests with the cache disabled = %d, want 2", got)
+	}
+
+	c = NewClient(WithProfileCacheTTL(time.Second), WithProfileCacheSize(8))
+	if c.profileCache.capacity != 8 || c.profileCache.ttl != time.Second {
+		t.Errorf("cache capacity %d, ttl %v; want 8, 1s", c.profileCache.capacity, c.profileCache.ttl)
+	}
+}
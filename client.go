@@ -1,12 +1,15 @@
 package polymarket
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
-	"strconv"
+	"sync"
 	"time"
 )
 
@@ -17,314 +20,250 @@ const (
 	// DataAPIBaseURL is the Polymarket Data API base URL
 	DataAPIBaseURL = "https://data-api.polymarket.com"
 
+	// CLOBBaseURL is the Polymarket CLOB (order book) API base URL
+	CLOBBaseURL = "https://clob.polymarket.com"
+
 	// DefaultTimeout is the default HTTP client timeout
 	DefaultTimeout = 30 * time.Second
+
+	// DefaultUserAgent is the User-Agent header sent with every request
+	DefaultUserAgent = "polymarket-go-client/1.0"
 )
 
 // Client is the main client for interacting with the Polymarket API
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
+	baseURL        string
+	dataAPIBaseURL string
+	clobBaseURL    string
+	wsBaseURL      string
+	httpClient     *http.Client
+	userAgent      string
+	headers        http.Header
+	logger         *slog.Logger
+	retryPolicy    *RetryPolicy
+	profileCache   *lruCache[string, *PublicProfile]
+
+	// L2 authentication, see WithL2Auth
+	address     string
+	credentials *APICredentials
+
+	limitersMu   sync.RWMutex
+	rateLimiters map[string]*RateLimiter
 }
 
-// NewClient creates a new Polymarket API client
-func NewClient() *Client {
-	return &Client{
-		baseURL: DefaultBaseURL,
+// NewClient creates a new Polymarket API client.
+// Without options it uses the public API hosts and default settings.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURL:        DefaultBaseURL,
+		dataAPIBaseURL: DataAPIBaseURL,
+		clobBaseURL:    CLOBBaseURL,
+		wsBaseURL:      CLOBWebSocketURL,
 		httpClient: &http.Client{
 			Timeout: DefaultTimeout,
 		},
+		userAgent:    DefaultUserAgent,
+		headers:      http.Header{},
+		profileCache: newLRUCache[string, *PublicProfile](DefaultProfileCacheSize, DefaultProfileCacheTTL),
 	}
-}
 
-// NewClientWithOptions creates a new client with custom options
-func NewClientWithOptions(baseURL string, timeout time.Duration) *Client {
-	if baseURL == "" {
-		baseURL = DefaultBaseURL
+	for _, opt := range opts {
+		opt(c)
 	}
-
-	return &Client{
-		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+	if c.credentials != nil {
+		c.installL2Auth()
 	}
+
+	return c
+}
+
+// NewClientWithOptions creates a new client with custom options.
+// It is equivalent to NewClient(WithBaseURL(baseURL), WithTimeout(timeout)).
+func NewClientWithOptions(baseURL string, timeout time.Duration) *Client {
+	return NewClient(WithBaseURL(baseURL), WithTimeout(timeout))
 }
 
 // SetTimeout sets the HTTP client timeout
 func (c *Client) SetTimeout(timeout time.Duration) {
-	c.httpClient.Timeout = timeout
+	c.cloneHTTPClient().Timeout = timeout
 }
 
-// makeRequest performs an HTTP request and returns the response body
-func (c *Client) makeRequest(method, endpoint string, params url.Values) ([]byte, error) {
-	return c.makeRequestWithBaseURL(c.baseURL, method, endpoint, params)
+// cloneHTTPClient replaces the HTTP client with a shallow copy and returns it,
+// so that tuning it never modifies a client passed to WithHTTPClient
+func (c *Client) cloneHTTPClient() *http.Client {
+	httpClient := *c.httpClient
+	c.httpClient = &httpClient
+	return c.httpClient
 }
 
-// makeRequestWithBaseURL performs an HTTP request with a custom base URL
-func (c *Client) makeRequestWithBaseURL(baseURL, method, endpoint string, params url.Values) ([]byte, error) {
-	// Construct full URL
-	fullURL := baseURL + endpoint
-	if len(params) > 0 {
-		fullURL += "?" + params.Encode()
-	}
+// SetLogger sets the logger used for request diagnostics.
+// Requests are logged at debug level; a nil logger disables logging (the default).
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
 
-	// Create request
-	req, err := http.NewRequest(method, fullURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// logDebug logs a debug message if a logger is configured
+func (c *Client) logDebug(msg string, args ...any) {
+	if c.logger != nil {
+		c.logger.Debug(msg, args...)
 	}
+}
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "polymarket-go-client/1.0")
+// SetRetryPolicy sets the policy used to retry failed GET requests.
+// A nil policy disables retries.
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) {
+	c.retryPolicy = policy
+}
 
-	// Make request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer resp.Body.Close()
+// makeRequest performs an HTTP request and returns the response body
+func (c *Client) makeRequest(ctx context.Context, method, endpoint string, params url.Values) ([]byte, error) {
+	return c.makeRequestWithBaseURL(ctx, c.baseURL, method, endpoint, params)
+}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+// makeRequestWithBaseURL performs an HTTP request with a custom base URL
+func (c *Client) makeRequestWithBaseURL(ctx context.Context, baseURL, method, endpoint string, params url.Values) ([]byte, error) {
+	return c.makeRequestWithBody(ctx, baseURL, method, endpoint, params, nil)
+}
+
+// makeRequestWithBody performs an HTTP request with a custom base URL and an
+// optional JSON request body. The request is bound to ctx, so cancelling it
+// aborts the call in flight. Every attempt waits on the rate limiter
+// configured for baseURL, and GET requests are retried according to the
+// client's retry policy.
+func (c *Client) makeRequestWithBody(ctx context.Context, baseURL, method, endpoint string, params url.Values, reqBody interface{}) ([]byte, error) {
+	if ctx == nil {
+		ctx = context.Background()
 	}
 
-	// Check for API errors
-	if resp.StatusCode != http.StatusOK {
-		var apiErr APIError
-		if err := json.Unmarshal(body, &apiErr); err != nil {
-			return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	var payload []byte
+	if reqBody != nil {
+		var err error
+		if payload, err = json.Marshal(reqBody); err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
 		}
-		apiErr.Code = resp.StatusCode
-		return nil, &apiErr
 	}
 
-	return body, nil
-}
+	// Construct full URL
+	fullURL := baseURL + endpoint
+	if len(params) > 0 {
+		fullURL += "?" + params.Encode()
+	}
 
-// buildParams converts struct parameters to url.Values
-func buildParams(params interface{}) url.Values {
-	values := url.Values{}
+	// Only idempotent requests are safe to retry
+	policy := c.retryPolicy
+	maxAttempts := 1
+	if method == http.MethodGet && policy != nil && policy.MaxAttempts > 1 {
+		maxAttempts = policy.MaxAttempts
+	}
 
-	switch p := params.(type) {
-	case *MarketsParams:
-		if p == nil {
-			return values
-		}
-		if p.Limit > 0 {
-			values.Add("limit", strconv.Itoa(p.Limit))
-		}
-		if p.Offset > 0 {
-			values.Add("offset", strconv.Itoa(p.Offset))
-		}
-		if p.Order != "" {
-			values.Add("order", p.Order)
-		}
-		values.Add("ascending", strconv.FormatBool(p.Ascending))
-		if p.Active != nil {
-			values.Add("active", strconv.FormatBool(*p.Active))
-		}
-		if p.Closed != nil {
-			values.Add("closed", strconv.FormatBool(*p.Closed))
-		}
-		if p.Archived != nil {
-			values.Add("archived", strconv.FormatBool(*p.Archived))
-		}
-		if p.Slug != "" {
-			values.Add("slug", p.Slug)
-		}
-		if p.EventID != "" {
-			values.Add("event_id", p.EventID)
-		}
-		if p.TagID != "" {
-			values.Add("tag_id", p.TagID)
+	for attempt := 1; ; attempt++ {
+		if err := c.waitRateLimit(ctx, baseURL); err != nil {
+			return nil, fmt.Errorf("rate limiter: %w", err)
 		}
 
-	case *EventsParams:
-		if p == nil {
-			return values
-		}
-		// Pagination
-		if p.Limit > 0 {
-			values.Add("limit", strconv.Itoa(p.Limit))
-		}
-		if p.Offset > 0 {
-			values.Add("offset", strconv.Itoa(p.Offset))
+		// A request that cannot be built will not succeed on a later attempt
+		req, err := c.newRequest(ctx, method, fullURL, payload)
+		if err != nil {
+			return nil, err
 		}
 
-		// Sorting
-		if p.Order != "" {
-			values.Add("order", p.Order)
+		body, status, retryAfter, err := c.doRequest(req, endpoint, fullURL, attempt)
+		if err == nil {
+			return body, nil
 		}
-		values.Add("ascending", strconv.FormatBool(p.Ascending))
 
-		// Basic filters
-		if len(p.ID) > 0 {
-			for _, id := range p.ID {
-				values.Add("id", id)
-			}
-		}
-		if len(p.Slug) > 0 {
-			for _, slug := range p.Slug {
-				values.Add("slug", slug)
-			}
-		}
-		if p.Active != nil {
-			values.Add("active", strconv.FormatBool(*p.Active))
-		}
-		if p.Closed != nil {
-			values.Add("closed", strconv.FormatBool(*p.Closed))
-		}
-		if p.Archived != nil {
-			values.Add("archived", strconv.FormatBool(*p.Archived))
+		if attempt >= maxAttempts || ctx.Err() != nil || !policy.shouldRetry(status, err) {
+			return nil, err
 		}
 
-		// Advanced filters
-		if p.TagID != nil {
-			values.Add("tag_id", strconv.Itoa(*p.TagID))
-		}
-		if len(p.ExcludeTagID) > 0 {
-			for _, tagID := range p.ExcludeTagID {
-				values.Add("exclude_tag_id", strconv.Itoa(tagID))
+		wait := policy.backoff(attempt)
+		if retryAfter > 0 && (status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable) {
+			wait = retryAfter
+			if policy.MaxBackoff > 0 && wait > policy.MaxBackoff {
+				wait = policy.MaxBackoff
 			}
 		}
-		if p.RelatedTags != nil {
-			values.Add("related_tags", strconv.FormatBool(*p.RelatedTags))
-		}
-		if p.Featured != nil {
-			values.Add("featured", strconv.FormatBool(*p.Featured))
-		}
-		if p.CYOM != nil {
-			values.Add("cyom", strconv.FormatBool(*p.CYOM))
-		}
-		if p.Recurrence != "" {
-			values.Add("recurrence", p.Recurrence)
-		}
-
-		// Date filters
-		if p.StartDateMin != nil {
-			values.Add("start_date_min", p.StartDateMin.Format(time.RFC3339))
-		}
-		if p.StartDateMax != nil {
-			values.Add("start_date_max", p.StartDateMax.Format(time.RFC3339))
-		}
-		if p.EndDateMin != nil {
-			values.Add("end_date_min", p.EndDateMin.Format(time.RFC3339))
-		}
-		if p.EndDateMax != nil {
-			values.Add("end_date_max", p.EndDateMax.Format(time.RFC3339))
+		c.logDebug("retrying polymarket request",
+			"method", method,
+			"url", fullURL,
+			"attempt", attempt,
+			"status", status,
+			"wait", wait,
+			"error", err,
+		)
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
 		}
+	}
+}
 
-	case *GetEventParams:
-		if p == nil {
-			return values
-		}
-		if p.IncludeChat != nil {
-			values.Add("include_chat", strconv.FormatBool(*p.IncludeChat))
-		}
-		if p.IncludeTemplate != nil {
-			values.Add("include_template", strconv.FormatBool(*p.IncludeTemplate))
-		}
+// newRequest creates the request for one attempt, with a fresh body reader
+func (c *Client) newRequest(ctx context.Context, method, fullURL string, payload []byte) (*http.Request, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
 
-	case *GetMarketParams:
-		if p == nil {
-			return values
-		}
-		if p.IncludeTag != nil {
-			values.Add("include_tag", strconv.FormatBool(*p.IncludeTag))
-		}
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if (req.URL.Scheme != "http" && req.URL.Scheme != "https") || req.URL.Host == "" {
+		return nil, fmt.Errorf("failed to create request: invalid URL %q", fullURL)
+	}
 
-	case *CommentsParams:
-		if p == nil {
-			return values
-		}
-		// Pagination
-		if p.Limit > 0 {
-			values.Add("limit", strconv.Itoa(p.Limit))
-		}
-		if p.Offset > 0 {
-			values.Add("offset", strconv.Itoa(p.Offset))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	for key, values := range c.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
 		}
+	}
 
-		// Sorting
-		if p.Order != "" {
-			values.Add("order", p.Order)
-		}
-		values.Add("ascending", strconv.FormatBool(p.Ascending))
+	return req, nil
+}
 
-		// Filters
-		if p.ParentEntityType != "" {
-			values.Add("parent_entity_type", p.ParentEntityType)
-		}
-		if p.ParentEntityID != nil {
-			values.Add("parent_entity_id", strconv.Itoa(*p.ParentEntityID))
-		}
-		if p.GetPositions != nil {
-			values.Add("get_positions", strconv.FormatBool(*p.GetPositions))
-		}
-		if p.HoldersOnly != nil {
-			values.Add("holders_only", strconv.FormatBool(*p.HoldersOnly))
-		}
+// doRequest performs a single HTTP request attempt. It returns the response
+// status (0 if no response was received) and any Retry-After delay alongside
+// the body so the caller can decide whether to retry.
+func (c *Client) doRequest(req *http.Request, endpoint, fullURL string, attempt int) ([]byte, int, time.Duration, error) {
+	method := req.Method
 
-	case *SearchParams:
-		if p == nil {
-			return values
-		}
-		// Required
-		if p.Q != "" {
-			values.Add("q", p.Q)
-		}
+	// Make request
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logDebug("polymarket request failed",
+			"method", method,
+			"url", fullURL,
+			"attempt", attempt,
+			"latency", time.Since(start),
+			"error", err,
+		)
+		return nil, 0, 0, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
 
-		// Pagination
-		if p.Page > 0 {
-			values.Add("page", strconv.Itoa(p.Page))
-		}
-		if p.LimitPerType > 0 {
-			values.Add("limit_per_type", strconv.Itoa(p.LimitPerType))
-		}
+	retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
 
-		// Sorting
-		if p.Sort != "" {
-			values.Add("sort", p.Sort)
-		}
-		values.Add("ascending", strconv.FormatBool(p.Ascending))
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	c.logDebug("polymarket request",
+		"method", method,
+		"url", fullURL,
+		"attempt", attempt,
+		"status", resp.StatusCode,
+		"latency", time.Since(start),
+		"bytes", len(body),
+	)
+	if err != nil {
+		return nil, resp.StatusCode, retryAfter, fmt.Errorf("failed to read response body: %w", err)
+	}
 
-		// Filters and options
-		if p.Cache != nil {
-			values.Add("cache", strconv.FormatBool(*p.Cache))
-		}
-		if p.EventsStatus != "" {
-			values.Add("events_status", p.EventsStatus)
-		}
-		if len(p.EventsTag) > 0 {
-			for _, tag := range p.EventsTag {
-				values.Add("events_tag", tag)
-			}
-		}
-		if p.KeepClosedMarkets != nil {
-			values.Add("keep_closed_markets", strconv.Itoa(*p.KeepClosedMarkets))
-		}
-		if p.SearchTags != nil {
-			values.Add("search_tags", strconv.FormatBool(*p.SearchTags))
-		}
-		if p.SearchProfiles != nil {
-			values.Add("search_profiles", strconv.FormatBool(*p.SearchProfiles))
-		}
-		if p.Recurrence != "" {
-			values.Add("recurrence", p.Recurrence)
-		}
-		if len(p.ExcludeTagID) > 0 {
-			for _, tagID := range p.ExcludeTagID {
-				values.Add("exclude_tag_id", strconv.Itoa(tagID))
-			}
-		}
-		if p.Optimized != nil {
-			values.Add("optimized", strconv.FormatBool(*p.Optimized))
-		}
+	// Check for API errors
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode, retryAfter, newAPIError(resp.StatusCode, endpoint, fullURL, body, retryAfter)
 	}
 
-	return values
+	return body, resp.StatusCode, retryAfter, nil
 }
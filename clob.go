@@ -0,0 +1,321 @@
+package polymarket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// GetOrderBook retrieves the CLOB order book for a token
+func (c *Client) GetOrderBook(tokenID string) (*OrderBook, error) {
+	return c.GetOrderBookContext(context.Background(), tokenID)
+}
+
+// GetOrderBookContext is like GetOrderBook but uses ctx for cancellation and deadlines
+func (c *Client) GetOrderBookContext(ctx context.Context, tokenID string) (*OrderBook, error) {
+	params, err := tokenParams(tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequestWithBaseURL(ctx, c.clobBaseURL, "GET", "/book", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order book for token %s: %w", tokenID, err)
+	}
+
+	var book OrderBook
+	if err := decodeJSON(body, &book); err != nil {
+		return nil, fmt.Errorf("failed to parse order book response: %w", err)
+	}
+
+	return &book, nil
+}
+
+// GetOrderBooks retrieves the CLOB order books for several tokens in one request
+func (c *Client) GetOrderBooks(tokenIDs []string) ([]OrderBook, error) {
+	return c.GetOrderBooksContext(context.Background(), tokenIDs)
+}
+
+// GetOrderBooksContext is like GetOrderBooks but uses ctx for cancellation and deadlines
+func (c *Client) GetOrderBooksContext(ctx context.Context, tokenIDs []string) ([]OrderBook, error) {
+	if len(tokenIDs) == 0 {
+		return nil, fmt.Errorf("at least one token ID is required")
+	}
+
+	type bookRequest struct {
+		TokenID string `json:"token_id"`
+	}
+	requests := make([]bookRequest, len(tokenIDs))
+	for i, id := range tokenIDs {
+		requests[i].TokenID = id
+	}
+
+	body, err := c.makeRequestWithBody(ctx, c.clobBaseURL, "POST", "/books", nil, requests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order books: %w", err)
+	}
+
+	var books []OrderBook
+	if err := decodeJSON(body, &books); err != nil {
+		return nil, fmt.Errorf("failed to parse order books response: %w", err)
+	}
+
+	return books, nil
+}
+
+// GetMarketOrderBooks retrieves the order books for every outcome token of a market,
+// in the order of the market's outcomes. It fails with an error wrapping
+// ErrNotFound if the CLOB returns no book for one of the tokens.
+func (c *Client) GetMarketOrderBooks(market *Market) ([]OrderBook, error) {
+	return c.GetMarketOrderBooksContext(context.Background(), market)
+}
+
+// GetMarketOrderBooksContext is like GetMarketOrderBooks but uses ctx for cancellation and deadlines
+func (c *Client) GetMarketOrderBooksContext(ctx context.Context, market *Market) ([]OrderBook, error) {
+	if market == nil {
+		return nil, fmt.Errorf("market is nil")
+	}
+	tokenIDs, err := market.TokenIDs()
+	if err != nil {
+		return nil, err
+	}
+	if len(tokenIDs) == 0 {
+		return nil, fmt.Errorf("%w: market has no outcome tokens", ErrInvalidOutcomes)
+	}
+
+	books, err := c.GetOrderBooksContext(ctx, tokenIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API doesn't guarantee response order, so line books up with the outcomes
+	byToken := make(map[string]OrderBook, len(books))
+	for _, book := range books {
+		byToken[book.AssetID] = book
+	}
+	ordered := make([]OrderBook, 0, len(tokenIDs))
+	for _, id := range tokenIDs {
+		book, ok := byToken[id]
+		if !ok {
+			return nil, fmt.Errorf("no order book for token %s: %w", id, ErrNotFound)
+		}
+		ordered = append(ordered, book)
+	}
+
+	return ordered, nil
+}
+
+// GetPrice retrieves the best price available to an order on the given side
+func (c *Client) GetPrice(tokenID string, side Side) (Decimal, error) {
+	return c.GetPriceContext(context.Background(), tokenID, side)
+}
+
+// GetPriceContext is like GetPrice but uses ctx for cancellation and deadlines
+func (c *Client) GetPriceContext(ctx context.Context, tokenID string, side Side) (Decimal, error) {
+	params, err := tokenParams(tokenID)
+	if err != nil {
+		return Decimal{}, err
+	}
+	params.Add("side", string(side))
+
+	var resp struct {
+		Price Decimal `json:"price"`
+	}
+	if err := c.getCLOB(ctx, "/price", params, &resp); err != nil {
+		return Decimal{}, fmt.Errorf("failed to fetch price for token %s: %w", tokenID, err)
+	}
+
+	return resp.Price, nil
+}
+
+// GetMidpoint retrieves the midpoint between the best bid and best ask of a token
+func (c *Client) GetMidpoint(tokenID string) (Decimal, error) {
+	return c.GetMidpointContext(context.Background(), tokenID)
+}
+
+// GetMidpointContext is like GetMidpoint but uses ctx for cancellation and deadlines
+func (c *Client) GetMidpointContext(ctx context.Context, tokenID string) (Decimal, error) {
+	params, err := tokenParams(tokenID)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	var resp struct {
+		Mid Decimal `json:"mid"`
+	}
+	if err := c.getCLOB(ctx, "/midpoint", params, &resp); err != nil {
+		return Decimal{}, fmt.Errorf("failed to fetch midpoint for token %s: %w", tokenID, err)
+	}
+
+	return resp.Mid, nil
+}
+
+// GetSpread retrieves the spread between the best bid and best ask of a token
+func (c *Client) GetSpread(tokenID string) (Decimal, error) {
+	return c.GetSpreadContext(context.Background(), tokenID)
+}
+
+// GetSpreadContext is like GetSpread but uses ctx for cancellation and deadlines
+func (c *Client) GetSpreadContext(ctx context.Context, tokenID string) (Decimal, error) {
+	params, err := tokenParams(tokenID)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	var resp struct {
+		Spread Decimal `json:"spread"`
+	}
+	if err := c.getCLOB(ctx, "/spread", params, &resp); err != nil {
+		return Decimal{}, fmt.Errorf("failed to fetch spread for token %s: %w", tokenID, err)
+	}
+
+	return resp.Spread, nil
+}
+
+// GetLastTradePrice retrieves the price and side of the last trade of a token
+func (c *Client) GetLastTradePrice(tokenID string) (*LastTradePrice, error) {
+	return c.GetLastTradePriceContext(context.Background(), tokenID)
+}
+
+// GetLastTradePriceContext is like GetLastTradePrice but uses ctx for cancellation and deadlines
+func (c *Client) GetLastTradePriceContext(ctx context.Context, tokenID string) (*LastTradePrice, error) {
+	params, err := tokenParams(tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	var last LastTradePrice
+	if err := c.getCLOB(ctx, "/last-trade-price", params, &last); err != nil {
+		return nil, fmt.Errorf("failed to fetch last trade price for token %s: %w", tokenID, err)
+	}
+
+	return &last, nil
+}
+
+// GetTickSize retrieves the minimum price increment of a token
+func (c *Client) GetTickSize(tokenID string) (Decimal, error) {
+	return c.GetTickSizeContext(context.Background(), tokenID)
+}
+
+// GetTickSizeContext is like GetTickSize but uses ctx for cancellation and deadlines
+func (c *Client) GetTickSizeContext(ctx context.Context, tokenID string) (Decimal, error) {
+	params, err := tokenParams(tokenID)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	var resp struct {
+		MinimumTickSize Decimal `json:"minimum_tick_size"`
+	}
+	if err := c.getCLOB(ctx, "/tick-size", params, &resp); err != nil {
+		return Decimal{}, fmt.Errorf("failed to fetch tick size for token %s: %w", tokenID, err)
+	}
+
+	return resp.MinimumTickSize, nil
+}
+
+// GetNegRisk reports whether a token trades on the negative-risk exchange
+func (c *Client) GetNegRisk(tokenID string) (bool, error) {
+	return c.GetNegRiskContext(context.Background(), tokenID)
+}
+
+// GetNegRiskContext is like GetNegRisk but uses ctx for cancellation and deadlines
+func (c *Client) GetNegRiskContext(ctx context.Context, tokenID string) (bool, error) {
+	params, err := tokenParams(tokenID)
+	if err != nil {
+		return false, err
+	}
+
+	var resp struct {
+		NegRisk bool `json:"neg_risk"`
+	}
+	if err := c.getCLOB(ctx, "/neg-risk", params, &resp); err != nil {
+		return false, fmt.Errorf("failed to fetch neg risk flag for token %s: %w", tokenID, err)
+	}
+
+	return resp.NegRisk, nil
+}
+
+// getCLOB performs a GET request against the CLOB API and decodes the response into v
+func (c *Client) getCLOB(ctx context.Context, endpoint string, params url.Values, v interface{}) error {
+	body, err := c.makeRequestWithBaseURL(ctx, c.clobBaseURL, "GET", endpoint, params)
+	if err != nil {
+		return err
+	}
+	return decodeJSON(body, v)
+}
+
+// tokenParams builds the token_id query parameter shared by CLOB endpoints
+func tokenParams(tokenID string) (url.Values, error) {
+	if tokenID == "" {
+		return nil, fmt.Errorf("token ID is required")
+	}
+
+	params := url.Values{}
+	params.Add("token_id", tokenID)
+	return params, nil
+}
+
+// TokenIDs returns the CLOB token IDs of the market's outcomes, in outcome order
+func (m *Market) TokenIDs() ([]string, error) {
+	outcomes, err := m.ParsedOutcomes()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIDs := make([]string, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		if outcome.TokenID == "" {
+			return nil, fmt.Errorf("%w: no token ID for outcome %q", ErrInvalidOutcomes, outcome.Name)
+		}
+		tokenIDs = append(tokenIDs, outcome.TokenID)
+	}
+
+	return tokenIDs, nil
+}
+
+// BestBid returns the highest bid, if any
+func (b *OrderBook) BestBid() (PriceLevel, bool) {
+	var best PriceLevel
+	found := false
+	for _, level := range b.Bids {
+		if !found || level.Price.GreaterThan(best.Price) {
+			best, found = level, true
+		}
+	}
+	return best, found
+}
+
+// BestAsk returns the lowest ask, if any
+func (b *OrderBook) BestAsk() (PriceLevel, bool) {
+	var best PriceLevel
+	found := false
+	for _, level := range b.Asks {
+		if !found || level.Price.LessThan(best.Price) {
+			best, found = level, true
+		}
+	}
+	return best, found
+}
+
+// Midpoint returns the midpoint between the best bid and best ask.
+// It reports false if either side of the book is empty.
+func (b *OrderBook) Midpoint() (Decimal, bool) {
+	bid, okBid := b.BestBid()
+	ask, okAsk := b.BestAsk()
+	if !okBid || !okAsk {
+		return Decimal{}, false
+	}
+	return bid.Price.Add(ask.Price).Mul(NewDecimal(5, 1)), true
+}
+
+// Spread returns the difference between the best ask and best bid.
+// It reports false if either side of the book is empty.
+func (b *OrderBook) Spread() (Decimal, bool) {
+	bid, okBid := b.BestBid()
+	ask, okAsk := b.BestAsk()
+	if !okBid || !okAsk {
+		return Decimal{}, false
+	}
+	return ask.Price.Sub(bid.Price), true
+}
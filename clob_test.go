@@ -0,0 +1,112 @@
+package polymarket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// booksServer serves POST /books with a book for each requested token in
+// returned, in reverse order
+func booksServer(t *testing.T, returned map[string]bool) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []struct {
+			TokenID string `json:"token_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		books := []map[string]string{}
+		for i := len(requests) - 1; i >= 0; i-- {
+			if returned[requests[i].TokenID] {
+				books = append(books, map[string]string{"asset_id": requests[i].TokenID})
+			}
+		}
+		json.NewEncoder(w).Encode(books)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetMarketOrderBooksOrdersByOutcome(t *testing.T) {
+	srv := booksServer(t, map[string]bool{"111": true, "222": true})
+	c := NewClient(WithCLOBBaseURL(srv.URL))
+	market := &Market{Outcomes: `["Yes", "No"]`, ClobTokenIDs: `["111", "222"]`}
+
+	books, err := c.GetMarketOrderBooksContext(context.Background(), market)
+	if err != nil {
+		t.Fatalf("GetMarketOrderBooks: %v", err)
+	}
+	if len(books) != 2 || books[0].AssetID != "111" || books[1].AssetID != "222" {
+		t.Errorf("books = %+v, want 111 then 222", books)
+	}
+}
+
+func TestGetMarketOrderBooksMissingBook(t *testing.T) {
+	srv := booksServer(t, map[string]bool{"111": true})
+	c := NewClient(WithCLOBBaseURL(srv.URL))
+	market := &Market{Outcomes: `["Yes", "No"]`, ClobTokenIDs: `["111", "222"]`}
+
+	books, err := c.GetMarketOrderBooksContext(context.Background(), market)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("error = %v, want ErrNotFound", err)
+	}
+	if books != nil {
+		t.Errorf("books = %+v, want nil", books)
+	}
+}
+
+func TestGetMarketOrderBooksInvalidMarket(t *testing.T) {
+	srv := booksServer(t, nil)
+	c := NewClient(WithCLOBBaseURL(srv.URL))
+
+	if _, err := c.GetMarketOrderBooksContext(context.Background(), nil); err == nil {
+		t.Error("nil market: want error")
+	}
+	_, err := c.GetMarketOrderBooksContext(context.Background(), &Market{Outcomes: `[]`, ClobTokenIDs: `[]`})
+	if !errors.Is(err, ErrInvalidOutcomes) {
+		t.Errorf("no tokens: error = %v, want ErrInvalidOutcomes", err)
+	}
+}
+
+func TestGetMarketPriceHistory(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/prices-history" || q.Get("interval") != "1d" {
+			t.Errorf("request = %s", r.URL)
+		}
+		fmt.Fprintf(w, `{"history":[{"t":1700000000,"p":"0.%s"}]}`, q.Get("market"))
+	}))
+	defer srv.Close()
+	c := NewClient(WithCLOBBaseURL(srv.URL))
+	market := &Market{Outcomes: `["Yes", "No"]`, ClobTokenIDs: `["111", "222"]`}
+
+	histories, err := c.GetMarketPriceHistoryContext(context.Background(), market, &PriceHistoryParams{Interval: PriceHistoryInterval1d})
+	if err != nil {
+		t.Fatalf("GetMarketPriceHistory: %v", err)
+	}
+	if len(histories) != 2 || histories[0].Outcome.Name != "Yes" || histories[1].Outcome.TokenID != "222" {
+		t.Fatalf("histories = %+v", histories)
+	}
+	if got := histories[1].History[0].Price.String(); got != "0.222" {
+		t.Errorf("No price = %s, want 0.222", got)
+	}
+}
+
+func TestGetMarketPriceHistoryInvalidMarket(t *testing.T) {
+	c := NewClient(WithCLOBBaseURL("http://127.0.0.1:0"))
+
+	if _, err := c.GetMarketPriceHistoryContext(context.Background(), nil, nil); err == nil {
+		t.Error("nil market: want error")
+	}
+	_, err := c.GetMarketPriceHistoryContext(context.Background(), &Market{Outcomes: `[]`}, nil)
+	if !errors.Is(err, ErrInvalidOutcomes) {
+		t.Errorf("no tokens: error = %v, want ErrInvalidOutcomes", err)
+	}
+}
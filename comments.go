@@ -1,60 +1,80 @@
 package polymarket
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 )
 
 // GetComments retrieves a list of comments from the Polymarket API
 func (c *Client) GetComments(params *CommentsParams) ([]Comment, error) {
-	body, err := c.makeRequest("GET", "/comments", buildParams(params))
+	return c.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext is like GetComments but uses ctx for cancellation and deadlines
+func (c *Client) GetCommentsContext(ctx context.Context, params *CommentsParams) ([]Comment, error) {
+	body, err := c.makeRequest(ctx, "GET", "/comments", buildParams(params))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch comments: %w", err)
 	}
-	
+
 	var comments []Comment
-	if err := json.Unmarshal(body, &comments); err != nil {
+	if err := decodeJSON(body, &comments); err != nil {
 		return nil, fmt.Errorf("failed to parse comments response: %w", err)
 	}
-	
+
 	return comments, nil
 }
 
 // GetMarketComments retrieves comments for a specific market
 func (c *Client) GetMarketComments(marketID int, params *CommentsParams) ([]Comment, error) {
+	return c.GetMarketCommentsContext(context.Background(), marketID, params)
+}
+
+// GetMarketCommentsContext is like GetMarketComments but uses ctx for cancellation and deadlines
+func (c *Client) GetMarketCommentsContext(ctx context.Context, marketID int, params *CommentsParams) ([]Comment, error) {
 	if params == nil {
 		params = &CommentsParams{}
 	}
-	
+
 	// Set market-specific filters
 	params.ParentEntityType = "market"
 	params.ParentEntityID = &marketID
-	
-	return c.GetComments(params)
+
+	return c.GetCommentsContext(ctx, params)
 }
 
 // GetEventComments retrieves comments for a specific event
 func (c *Client) GetEventComments(eventID int, params *CommentsParams) ([]Comment, error) {
+	return c.GetEventCommentsContext(context.Background(), eventID, params)
+}
+
+// GetEventCommentsContext is like GetEventComments but uses ctx for cancellation and deadlines
+func (c *Client) GetEventCommentsContext(ctx context.Context, eventID int, params *CommentsParams) ([]Comment, error) {
 	if params == nil {
 		params = &CommentsParams{}
 	}
-	
+
 	// Set event-specific filters
 	params.ParentEntityType = "Event"
 	params.ParentEntityID = &eventID
-	
-	return c.GetComments(params)
+
+	return c.GetCommentsContext(ctx, params)
 }
 
 // GetSeriesComments retrieves comments for a specific series
 func (c *Client) GetSeriesComments(seriesID int, params *CommentsParams) ([]Comment, error) {
+	return c.GetSeriesCommentsContext(context.Background(), seriesID, params)
+}
+
+// GetSeriesCommentsContext is like GetSeriesComments but uses ctx for cancellation and deadlines
+func (c *Client) GetSeriesCommentsContext(ctx context.Context, seriesID int, params *CommentsParams) ([]Comment, error) {
 	if params == nil {
 		params = &CommentsParams{}
 	}
-	
+
 	// Set series-specific filters
 	params.ParentEntityType = "Series"
 	params.ParentEntityID = &seriesID
-	
-	return c.GetComments(params)
-}
\ No newline at end of file
+
+	return c.GetCommentsContext(ctx, params)
+}
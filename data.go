@@ -0,0 +1,160 @@
+package polymarket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GetPositions retrieves a user's open positions from the Data API
+func (c *Client) GetPositions(params *PositionsParams) ([]Position, error) {
+	return c.GetPositionsContext(context.Background(), params)
+}
+
+// GetPositionsContext is like GetPositions but uses ctx for cancellation and deadlines
+func (c *Client) GetPositionsContext(ctx context.Context, params *PositionsParams) ([]Position, error) {
+	if params == nil || params.User == "" {
+		return nil, fmt.Errorf("user address is required")
+	}
+
+	body, err := c.makeRequestWithBaseURL(ctx, c.dataAPIBaseURL, "GET", "/positions", buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch positions for %s: %w", params.User, err)
+	}
+
+	var positions []Position
+	if err := decodeJSON(body, &positions); err != nil {
+		return nil, fmt.Errorf("failed to parse positions response: %w", err)
+	}
+
+	return positions, nil
+}
+
+// GetClosedPositions retrieves a user's closed positions from the Data API
+func (c *Client) GetClosedPositions(params *ClosedPositionsParams) ([]ClosedPosition, error) {
+	return c.GetClosedPositionsContext(context.Background(), params)
+}
+
+// GetClosedPositionsContext is like GetClosedPositions but uses ctx for cancellation and deadlines
+func (c *Client) GetClosedPositionsContext(ctx context.Context, params *ClosedPositionsParams) ([]ClosedPosition, error) {
+	if params == nil || params.User == "" {
+		return nil, fmt.Errorf("user address is required")
+	}
+
+	body, err := c.makeRequestWithBaseURL(ctx, c.dataAPIBaseURL, "GET", "/closed-positions", buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch closed positions for %s: %w", params.User, err)
+	}
+
+	var positions []ClosedPosition
+	if err := decodeJSON(body, &positions); err != nil {
+		return nil, fmt.Errorf("failed to parse closed positions response: %w", err)
+	}
+
+	return positions, nil
+}
+
+// GetTrades retrieves trades from the Data API, optionally filtered by user or market
+func (c *Client) GetTrades(params *TradesParams) ([]Trade, error) {
+	return c.GetTradesContext(context.Background(), params)
+}
+
+// GetTradesContext is like GetTrades but uses ctx for cancellation and deadlines
+func (c *Client) GetTradesContext(ctx context.Context, params *TradesParams) ([]Trade, error) {
+	body, err := c.makeRequestWithBaseURL(ctx, c.dataAPIBaseURL, "GET", "/trades", buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch trades: %w", err)
+	}
+
+	var trades []Trade
+	if err := decodeJSON(body, &trades); err != nil {
+		return nil, fmt.Errorf("failed to parse trades response: %w", err)
+	}
+
+	return trades, nil
+}
+
+// GetActivity retrieves a user's on-chain activity from the Data API
+func (c *Client) GetActivity(params *ActivityParams) ([]Activity, error) {
+	return c.GetActivityContext(context.Background(), params)
+}
+
+// GetActivityContext is like GetActivity but uses ctx for cancellation and deadlines
+func (c *Client) GetActivityContext(ctx context.Context, params *ActivityParams) ([]Activity, error) {
+	if params == nil || params.User == "" {
+		return nil, fmt.Errorf("user address is required")
+	}
+
+	body, err := c.makeRequestWithBaseURL(ctx, c.dataAPIBaseURL, "GET", "/activity", buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch activity for %s: %w", params.User, err)
+	}
+
+	var activity []Activity
+	if err := decodeJSON(body, &activity); err != nil {
+		return nil, fmt.Errorf("failed to parse activity response: %w", err)
+	}
+
+	return activity, nil
+}
+
+// GetPortfolioValue retrieves the total value of a user's positions,
+// optionally restricted to the given markets (condition IDs)
+func (c *Client) GetPortfolioValue(user string, markets []string) (*PortfolioValue, error) {
+	return c.GetPortfolioValueContext(context.Background(), user, markets)
+}
+
+// GetPortfolioValueContext is like GetPortfolioValue but uses ctx for cancellation and deadlines
+func (c *Client) GetPortfolioValueContext(ctx context.Context, user string, markets []string) (*PortfolioValue, error) {
+	if user == "" {
+		return nil, fmt.Errorf("user address is required")
+	}
+
+	params := url.Values{}
+	params.Add("user", user)
+	if len(markets) > 0 {
+		params.Add("market", strings.Join(markets, ","))
+	}
+
+	body, err := c.makeRequestWithBaseURL(ctx, c.dataAPIBaseURL, "GET", "/value", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch portfolio value for %s: %w", user, err)
+	}
+
+	// The API returns an array with a single entry for the user
+	var values []PortfolioValue
+	if err := decodeJSON(body, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse portfolio value response: %w", err)
+	}
+
+	if len(values) == 0 {
+		return &PortfolioValue{User: user}, nil
+	}
+
+	return &values[0], nil
+}
+
+// GetHolders retrieves the top holders of each outcome token of the given markets
+func (c *Client) GetHolders(params *HoldersParams) ([]TokenHolders, error) {
+	return c.GetHoldersContext(context.Background(), params)
+}
+
+// GetHoldersContext is like GetHolders but uses ctx for cancellation and deadlines
+func (c *Client) GetHoldersContext(ctx context.Context, params *HoldersParams) ([]TokenHolders, error) {
+	if params == nil || len(params.Market) == 0 {
+		return nil, fmt.Errorf("at least one market condition ID is required")
+	}
+
+	body, err := c.makeRequestWithBaseURL(ctx, c.dataAPIBaseURL, "GET", "/holders", buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch holders: %w", err)
+	}
+
+	var holders []TokenHolders
+	if err := decodeJSON(body, &holders); err != nil {
+		return nil, fmt.Errorf("failed to parse holders response: %w", err)
+	}
+
+	return holders, nil
+}
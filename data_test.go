@@ -0,0 +1,197 @@
+package polymarket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// dataAPIClient returns a client whose Data API host answers each path with
+// the given response and records the requests it receives. Requests to the
+// Gamma host fail the test.
+func dataAPIClient(t *testing.T, responses map[string]string) (*Client, <-chan recordedRequest) {
+	t.Helper()
+
+	requests := make(chan recordedRequest, 16)
+	data := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- recordedRequest{path: r.URL.Path, query: r.URL.RawQuery}
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(data.Close)
+
+	gamma := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("request %s sent to the Gamma API", r.URL)
+	}))
+	t.Cleanup(gamma.Close)
+
+	return NewClient(WithBaseURL(gamma.URL), WithDataAPIBaseURL(data.URL)), requests
+}
+
+// checkRequest fails unless the next recorded request has the given path and query
+func checkRequest(t *testing.T, requests <-chan recordedRequest, path, query string) {
+	t.Helper()
+
+	got := <-requests
+	if got.path != path || got.query != query {
+		t.Errorf("request = %s?%s, want %s?%s", got.path, got.query, path, query)
+	}
+}
+
+func TestGetPositions(t *testing.T) {
+	c, requests := dataAPIClient(t, map[string]string{"/positions": `[{
+		"proxyWallet":"0xabc","asset":"111","conditionId":"0x1","size":"25.5","avgPrice":"0.42",
+		"cashPnl":"-1.25","curPrice":"0.37","redeemable":true,"outcome":"Yes","outcomeIndex":0,"negativeRisk":true
+	}]`})
+
+	threshold := MustParseDecimal("1.5")
+	positions, err := c.GetPositionsContext(context.Background(), &PositionsParams{
+		User:          "0xabc",
+		Limit:         10,
+		SortBy:        "CASHPNL",
+		SortDirection: SortDesc,
+		Market:        []string{"0x1", "0x2"},
+		EventID:       []int{7, 8},
+		SizeThreshold: &threshold,
+		Redeemable:    boolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("GetPositions: %v", err)
+	}
+	checkRequest(t, requests, "/positions",
+		"eventId=7%2C8&limit=10&market=0x1%2C0x2&redeemable=false&sizeThreshold=1.5&sortBy=CASHPNL&sortDirection=DESC&user=0xabc")
+
+	if len(positions) != 1 {
+		t.Fatalf("got %d positions, want 1", len(positions))
+	}
+	p := positions[0]
+	if p.Asset != "111" || p.ConditionID != "0x1" || !p.Redeemable || !p.NegativeRisk || p.Outcome != "Yes" {
+		t.Errorf("position = %+v", p)
+	}
+	if !p.Size.Equal(MustParseDecimal("25.5")) || !p.AvgPrice.Equal(MustParseDecimal("0.42")) || !p.CashPnl.Equal(MustParseDecimal("-1.25")) {
+		t.Errorf("size %s, avgPrice %s, cashPnl %s; want 25.5, 0.42, -1.25", p.Size, p.AvgPrice, p.CashPnl)
+	}
+}
+
+func TestGetClosedPositions(t *testing.T) {
+	c, requests := dataAPIClient(t, map[string]string{"/closed-positions": `[{
+		"asset":"222","conditionId":"0x1","avgPrice":"0.3","realizedPnl":"12.75","timestamp":1700000000,"outcomeIndex":1
+	}]`})
+
+	positions, err := c.GetClosedPositionsContext(context.Background(), &ClosedPositionsParams{
+		User:   "0xabc",
+		Market: []string{"0x1"},
+		Title:  "btc",
+	})
+	if err != nil {
+		t.Fatalf("GetClosedPositions: %v", err)
+	}
+	checkRequest(t, requests, "/closed-positions", "market=0x1&title=btc&user=0xabc")
+
+	if len(positions) != 1 || positions[0].Asset != "222" || positions[0].Timestamp != 1700000000 || positions[0].OutcomeIndex != 1 ||
+		!positions[0].RealizedPnl.Equal(MustParseDecimal("12.75")) {
+		t.Errorf("closed positions = %+v", positions)
+	}
+}
+
+func TestGetTrades(t *testing.T) {
+	c, requests := dataAPIClient(t, map[string]string{"/trades": `[{
+		"proxyWallet":"0xdef","side":"BUY","asset":"111","size":"100","price":"0.55","timestamp":1700000001,"transactionHash":"0xfeed"
+	}]`})
+
+	amount := MustParseDecimal("10")
+	trades, err := c.GetTradesContext(context.Background(), &TradesParams{
+		Market:       []string{"0x1", "0x2"},
+		Side:         SideBuy,
+		TakerOnly:    boolPtr(true),
+		FilterType:   "CASH",
+		FilterAmount: &amount,
+	})
+	if err != nil {
+		t.Fatalf("GetTrades: %v", err)
+	}
+	checkRequest(t, requests, "/trades", "filterAmount=10&filterType=CASH&market=0x1%2C0x2&side=BUY&takerOnly=true")
+
+	if len(trades) != 1 || trades[0].Side != SideBuy || trades[0].TransactionHash != "0xfeed" ||
+		!trades[0].Size.Equal(MustParseDecimal("100")) || !trades[0].Price.Equal(MustParseDecimal("0.55")) {
+		t.Errorf("trades = %+v", trades)
+	}
+
+	// Trades can be listed without any filter
+	if _, err := c.GetTradesContext(context.Background(), nil); err != nil {
+		t.Fatalf("GetTrades without params: %v", err)
+	}
+	checkRequest(t, requests, "/trades", "")
+}
+
+func TestGetActivity(t *testing.T) {
+	c, requests := dataAPIClient(t, map[string]string{"/activity": `[
+		{"type":"TRADE","side":"SELL","size":"5","usdcSize":"2.5","price":"0.5","timestamp":1700000100},
+		{"type":"REDEEM","size":"20","usdcSize":"20","timestamp":1700000200}
+	]`})
+
+	activity, err := c.GetActivityContext(context.Background(), &ActivityParams{
+		User:  "0xabc",
+		Type:  []string{ActivityTrade, ActivityRedeem},
+		Start: 1700000000,
+		End:   1700086400,
+	})
+	if err != nil {
+		t.Fatalf("GetActivity: %v", err)
+	}
+	checkRequest(t, requests, "/activity", "end=1700086400&start=1700000000&type=TRADE%2CREDEEM&user=0xabc")
+
+	if len(activity) != 2 || activity[0].Type != ActivityTrade || activity[0].Side != SideSell || activity[1].Type != ActivityRedeem ||
+		!activity[0].UsdcSize.Equal(MustParseDecimal("2.5")) || activity[1].Timestamp != 1700000200 {
+		t.Errorf("activity = %+v", activity)
+	}
+}
+
+func TestGetPortfolioValue(t *testing.T) {
+	c, requests := dataAPIClient(t, map[string]string{"/value": `[{"user":"0xabc","value":"1234.56"}]`})
+
+	value, err := c.GetPortfolioValueContext(context.Background(), "0xabc", []string{"0x1", "0x2"})
+	if err != nil {
+		t.Fatalf("GetPortfolioValue: %v", err)
+	}
+	checkRequest(t, requests, "/value", "market=0x1%2C0x2&user=0xabc")
+	if value.User != "0xabc" || !value.Value.Equal(MustParseDecimal("1234.56")) {
+		t.Errorf("value = %+v, want 0xabc with 1234.56", value)
+	}
+
+	c, requests = dataAPIClient(t, map[string]string{"/value": `[]`})
+	value, err = c.GetPortfolioValueContext(context.Background(), "0xabc", nil)
+	if err != nil {
+		t.Fatalf("GetPortfolioValue: %v", err)
+	}
+	checkRequest(t, requests, "/value", "user=0xabc")
+	if value.User != "0xabc" || !value.Value.IsZero() {
+		t.Errorf("value without positions = %+v, want 0xabc with 0", value)
+	}
+}
+
+func TestDataAPIRequiresUser(t *testing.T) {
+	c, requests := dataAPIClient(t, nil)
+	ctx := context.Background()
+
+	if _, err := c.GetPositionsContext(ctx, nil); err == nil {
+		t.Error("GetPositions without params: want error")
+	}
+	if _, err := c.GetClosedPositionsContext(ctx, &ClosedPositionsParams{}); err == nil {
+		t.Error("GetClosedPositions without user: want error")
+	}
+	if _, err := c.GetActivityContext(ctx, &ActivityParams{Limit: 5}); err == nil {
+		t.Error("GetActivity without user: want error")
+	}
+	if _, err := c.GetPortfolioValueContext(ctx, "", nil); err == nil {
+		t.Error("GetPortfolioValue without user: want error")
+	}
+	if len(requests) != 0 {
+		t.Errorf("%d requests sent, want 0", len(requests))
+	}
+}
@@ -0,0 +1,402 @@
+package polymarket
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number used for prices, sizes and USDC amounts.
+// It is immutable: arithmetic methods return new values. The zero value is 0.
+//
+// Decimals decode leniently from JSON numbers, quoted numbers, empty strings
+// and null, and encode as quoted strings so no precision is lost.
+type Decimal struct {
+	coef  *big.Int // nil means zero
+	scale int32    // value = coef * 10^-scale, scale >= 0
+}
+
+var bigTen = big.NewInt(10)
+
+// maxDecimalExponent bounds the exponent accepted by ParseDecimal, so that
+// a hostile payload such as 1e2000000000 cannot make arithmetic hang
+const maxDecimalExponent = 1000
+
+// NewDecimal returns coef * 10^-scale, e.g. NewDecimal(55, 2) is 0.55.
+// It panics if scale is math.MinInt32, whose negation overflows.
+func NewDecimal(coef int64, scale int32) Decimal {
+	if scale == math.MinInt32 {
+		panic("polymarket: decimal scale out of range")
+	}
+	if scale < 0 {
+		return Decimal{coef: new(big.Int).Mul(big.NewInt(coef), pow10(-scale))}
+	}
+	return Decimal{coef: big.NewInt(coef), scale: scale}
+}
+
+// NewDecimalFromInt returns the decimal value of i
+func NewDecimalFromInt(i int64) Decimal {
+	return Decimal{coef: big.NewInt(i)}
+}
+
+// NewDecimalFromFloat returns the shortest decimal that represents f.
+// NaN and infinities yield zero.
+func NewDecimalFromFloat(f float64) Decimal {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}
+	}
+	d, _ := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	return d
+}
+
+// ParseDecimal parses a decimal string such as "12", "-0.55" or "1.5e-3".
+// Exponents beyond ±1000 are rejected.
+func ParseDecimal(s string) (Decimal, error) {
+	str := strings.TrimSpace(s)
+	if str == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	// Split off the exponent
+	exp := 0
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		e, err := strconv.Atoi(str[i+1:])
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+		if e > maxDecimalExponent || e < -maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("invalid decimal %q: exponent out of range", s)
+		}
+		exp = e
+		str = str[:i]
+	}
+
+	negative := false
+	switch {
+	case strings.HasPrefix(str, "-"):
+		negative = true
+		str = str[1:]
+	case strings.HasPrefix(str, "+"):
+		str = str[1:]
+	}
+
+	intPart, fracPart := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		intPart, fracPart = str[:i], str[i+1:]
+	}
+	digits := intPart + fracPart
+	if digits == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+	}
+
+	coef, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	if negative {
+		coef.Neg(coef)
+	}
+
+	scale := len(fracPart) - exp
+	if scale < 0 {
+		coef.Mul(coef, pow10(int32(-scale)))
+		scale = 0
+	}
+	if scale > math.MaxInt32 {
+		return Decimal{}, fmt.Errorf("invalid decimal %q: too many digits", s)
+	}
+
+	return Decimal{coef: coef, scale: int32(scale)}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on invalid input.
+// It is intended for constants.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// pow10 returns 10^n
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// bigInt returns the coefficient, treating nil as zero
+func (d Decimal) bigInt() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// rescale returns the coefficient of d expressed with the given larger scale
+func (d Decimal) rescale(scale int32) *big.Int {
+	if scale == d.scale {
+		return d.bigInt()
+	}
+	return new(big.Int).Mul(d.bigInt(), pow10(scale-d.scale))
+}
+
+// align returns the coefficients of d and other at a common scale
+func (d Decimal) align(other Decimal) (*big.Int, *big.Int, int32) {
+	scale := d.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	return d.rescale(scale), other.rescale(scale), scale
+}
+
+// Add returns d + other
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b, scale := d.align(other)
+	return Decimal{coef: new(big.Int).Add(a, b), scale: scale}
+}
+
+// Sub returns d - other
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b, scale := d.align(other)
+	return Decimal{coef: new(big.Int).Sub(a, b), scale: scale}
+}
+
+// Mul returns d * other
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.bigInt(), other.bigInt()), scale: d.scale + other.scale}
+}
+
+// Div returns d / other rounded half away from zero to the given number of
+// decimal places. It panics if other is zero.
+func (d Decimal) Div(other Decimal, places int32) Decimal {
+	if other.IsZero() {
+		panic("polymarket: decimal division by zero")
+	}
+	return decimalFromRat(new(big.Rat).Quo(d.Rat(), other.Rat()), places)
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.bigInt()), scale: d.scale}
+}
+
+// Abs returns |d|
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.bigInt()), scale: d.scale}
+}
+
+// Cmp compares d and other, returning -1, 0 or +1
+func (d Decimal) Cmp(other Decimal) int {
+	a, b, _ := d.align(other)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d == other, regardless of trailing zeros
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// LessThan reports whether d < other
+func (d Decimal) LessThan(other Decimal) bool {
+	return d.Cmp(other) < 0
+}
+
+// GreaterThan reports whether d > other
+func (d Decimal) GreaterThan(other Decimal) bool {
+	return d.Cmp(other) > 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d
+func (d Decimal) Sign() int {
+	return d.bigInt().Sign()
+}
+
+// IsZero reports whether d is zero
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Round rounds d half away from zero to the given number of decimal places
+func (d Decimal) Round(places int32) Decimal {
+	return d.roundWith(places, func(q, r, divisor *big.Int) {
+		half := new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2))
+		if half.Cmp(divisor) >= 0 {
+			q.Add(q, big.NewInt(int64(r.Sign())))
+		}
+	})
+}
+
+// Truncate drops digits beyond the given number of decimal places (rounds toward zero)
+func (d Decimal) Truncate(places int32) Decimal {
+	return d.roundWith(places, func(q, r, divisor *big.Int) {})
+}
+
+// RoundUp rounds d away from zero to the given number of decimal places
+func (d Decimal) RoundUp(places int32) Decimal {
+	return d.roundWith(places, func(q, r, divisor *big.Int) {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	})
+}
+
+// places returns the number of significant decimal places of d, ignoring trailing zeros
+func (d Decimal) places() int32 {
+	coef := d.bigInt()
+	if coef.Sign() == 0 || d.scale <= 0 {
+		return 0
+	}
+
+	places := d.scale
+	ten := big.NewInt(10)
+	q, r := new(big.Int), new(big.Int)
+	for places > 0 {
+		q.QuoRem(coef, ten, r)
+		if r.Sign() != 0 {
+			break
+		}
+		coef = new(big.Int).Set(q)
+		places--
+	}
+	return places
+}
+
+// roundWith reduces d to places decimals; adjust fixes up the truncated
+// quotient q given the remainder r of dividing by divisor
+func (d Decimal) roundWith(places int32, adjust func(q, r, divisor *big.Int)) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if d.scale <= places {
+		return d
+	}
+
+	divisor := pow10(d.scale - places)
+	q, r := new(big.Int).QuoRem(d.bigInt(), divisor, new(big.Int))
+	if r.Sign() != 0 {
+		adjust(q, r, divisor)
+	}
+	return Decimal{coef: q, scale: places}
+}
+
+// Rat returns d as a big.Rat
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.bigInt(), pow10(d.scale))
+}
+
+// decimalFromRat converts r to a decimal rounded half away from zero to places
+func decimalFromRat(r *big.Rat, places int32) Decimal {
+	num := new(big.Int).Mul(r.Num(), pow10(places))
+	q, rem := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		half := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2))
+		if half.Cmp(r.Denom()) >= 0 {
+			q.Add(q, big.NewInt(int64(rem.Sign())))
+		}
+	}
+	return Decimal{coef: q, scale: places}
+}
+
+// Float64 returns the nearest float64 to d
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// IntPart returns the integer part of d, truncated toward zero, as a new big.Int
+func (d Decimal) IntPart() *big.Int {
+	return new(big.Int).Set(d.Truncate(0).bigInt())
+}
+
+// String formats d in plain notation without trailing zeros
+func (d Decimal) String() string {
+	s := d.format()
+	if strings.IndexByte(s, '.') >= 0 {
+		s = strings.TrimRight(s, "0")
+		s = strings.TrimSuffix(s, ".")
+	}
+	return s
+}
+
+// StringFixed formats d rounded to exactly the given number of decimal places
+func (d Decimal) StringFixed(places int32) string {
+	if places < 0 {
+		places = 0
+	}
+	r := d.Round(places)
+	return Decimal{coef: r.rescale(places), scale: places}.format()
+}
+
+// format writes the coefficient with the decimal point at scale
+func (d Decimal) format() string {
+	coef := d.bigInt()
+	digits := new(big.Int).Abs(coef).String()
+
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		point := len(digits) - int(d.scale)
+		digits = digits[:point] + "." + digits[point:]
+	}
+
+	if coef.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// MarshalJSON encodes d as a quoted string
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON accepts numbers, quoted numbers, empty strings and null
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+
+	s := string(data)
+	if len(data) >= 2 && data[0] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return fmt.Errorf("invalid decimal %s", s)
+		}
+		s = unquoted
+		if strings.TrimSpace(s) == "" {
+			*d = Decimal{}
+			return nil
+		}
+	}
+
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalText encodes d in plain notation
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses d from plain or exponent notation
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,216 @@
+package polymarket
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"12", "12"},
+		{"-0.55", "-0.55"},
+		{"+1.50", "1.5"},
+		{".52", "0.52"},
+		{"5.", "5"},
+		{"0.000", "0"},
+		{" 7.25 ", "7.25"},
+		{"1.5e-3", "0.0015"},
+		{"1.5E3", "1500"},
+		{"-2e+2", "-200"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789"},
+		{"1e1000", "1" + strings.Repeat("0", 1000)},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		if err != nil {
+			t.Errorf("ParseDecimal(%q): %v", tt.in, err)
+			continue
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseDecimalInvalid(t *testing.T) {
+	for _, in := range []string{"", " ", "-", ".", "abc", "1.2.3", "1e", "1e1.5", "0x10", "1,5", "--1", "NaN"} {
+		if d, err := ParseDecimal(in); err == nil {
+			t.Errorf("ParseDecimal(%q) = %s, want error", in, d)
+		}
+	}
+}
+
+func TestParseDecimalExponentBound(t *testing.T) {
+	start := time.Now()
+	for _, in := range []string{"1e2000000000", "1e-2000000000", "1e1001", "-3.5e-1001"} {
+		if _, err := ParseDecimal(in); err == nil {
+			t.Errorf("ParseDecimal(%q) succeeded, want exponent error", in)
+		}
+	}
+
+	var d Decimal
+	if err := json.Unmarshal([]byte(`1e2000000000`), &d); err == nil {
+		t.Error("Unmarshal of 1e2000000000 succeeded, want error")
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("rejecting huge exponents took %v", elapsed)
+	}
+}
+
+func TestDecimalUnmarshalJSONLenient(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`0.55`, "0.55"},
+		{`"0.55"`, "0.55"},
+		{`12`, "12"},
+		{`"1e-2"`, "0.01"},
+		{`""`, "0"},
+		{`"  "`, "0"},
+		{`null`, "0"},
+	}
+	for _, tt := range tests {
+		var d Decimal
+		if err := json.Unmarshal([]byte(tt.in), &d); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.in, err)
+			continue
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("Unmarshal(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{`"abc"`, `true`, `{}`, `[1]`} {
+		var d Decimal
+		if err := json.Unmarshal([]byte(in), &d); err == nil {
+			t.Errorf("Unmarshal(%s) = %s, want error", in, d)
+		}
+	}
+}
+
+func TestDecimalJSONRoundTrip(t *testing.T) {
+	in := struct {
+		Price Decimal `json:"price"`
+	}{MustParseDecimal("0.123456789012345678")}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `{"price":"0.123456789012345678"}` {
+		t.Errorf("Marshal = %s", data)
+	}
+
+	var out struct {
+		Price Decimal `json:"price"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !out.Price.Equal(in.Price) {
+		t.Errorf("round trip = %s, want %s", out.Price, in.Price)
+	}
+}
+
+func TestDecimalRounding(t *testing.T) {
+	tests := []struct {
+		in                       string
+		places                   int32
+		round, truncate, roundUp string
+	}{
+		{"1.2345", 2, "1.23", "1.23", "1.24"},
+		{"1.235", 2, "1.24", "1.23", "1.24"},
+		{"-1.235", 2, "-1.24", "-1.23", "-1.24"},
+		{"-1.234", 2, "-1.23", "-1.23", "-1.24"},
+		{"0.5", 0, "1", "0", "1"},
+		{"-0.5", 0, "-1", "0", "-1"},
+		{"2.5", 0, "3", "2", "3"},
+		{"1.2", 3, "1.2", "1.2", "1.2"},
+		{"7", 2, "7", "7", "7"},
+	}
+	for _, tt := range tests {
+		d := MustParseDecimal(tt.in)
+		if got := d.Round(tt.places).String(); got != tt.round {
+			t.Errorf("%s.Round(%d) = %s, want %s", tt.in, tt.places, got, tt.round)
+		}
+		if got := d.Truncate(tt.places).String(); got != tt.truncate {
+			t.Errorf("%s.Truncate(%d) = %s, want %s", tt.in, tt.places, got, tt.truncate)
+		}
+		if got := d.RoundUp(tt.places).String(); got != tt.roundUp {
+			t.Errorf("%s.RoundUp(%d) = %s, want %s", tt.in, tt.places, got, tt.roundUp)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, b := MustParseDecimal("0.1"), MustParseDecimal("0.2")
+	if got := a.Add(b).String(); got != "0.3" {
+		t.Errorf("0.1 + 0.2 = %s", got)
+	}
+	if got := a.Sub(b).String(); got != "-0.1" {
+		t.Errorf("0.1 - 0.2 = %s", got)
+	}
+	if got := a.Mul(b).String(); got != "0.02" {
+		t.Errorf("0.1 * 0.2 = %s", got)
+	}
+	if got := NewDecimalFromInt(2).Div(NewDecimalFromInt(3), 4).String(); got != "0.6667" {
+		t.Errorf("2 / 3 = %s", got)
+	}
+	if got := NewDecimalFromInt(-1).Div(NewDecimalFromInt(8), 2).String(); got != "-0.13" {
+		t.Errorf("-1 / 8 = %s", got)
+	}
+	if got := MustParseDecimal("1.005").StringFixed(2); got != "1.01" {
+		t.Errorf("StringFixed(2) = %s", got)
+	}
+	if got := MustParseDecimal("3").StringFixed(2); got != "3.00" {
+		t.Errorf("StringFixed(2) = %s", got)
+	}
+	if !MustParseDecimal("1.50").Equal(MustParseDecimal("1.5")) {
+		t.Error("1.50 != 1.5")
+	}
+	if MustParseDecimal("0.49").Cmp(MustParseDecimal("0.5")) != -1 {
+		t.Error("0.49 >= 0.5")
+	}
+
+	var zero Decimal
+	if !zero.IsZero() || zero.String() != "0" || zero.Add(a).String() != "0.1" {
+		t.Error("zero value is not usable as 0")
+	}
+}
+
+func TestDecimalIntPartDoesNotAlias(t *testing.T) {
+	for _, d := range []Decimal{NewDecimalFromInt(5), NewDecimal(5, -2), MustParseDecimal("5.75")} {
+		before := d.String()
+		d.IntPart().SetInt64(99)
+		if got := d.String(); got != before {
+			t.Errorf("modifying IntPart changed %s to %s", before, got)
+		}
+	}
+	if got := MustParseDecimal("-7.9").IntPart().Int64(); got != -7 {
+		t.Errorf("IntPart(-7.9) = %d, want -7", got)
+	}
+}
+
+func TestNewDecimalScale(t *testing.T) {
+	if got := NewDecimal(55, 2).String(); got != "0.55" {
+		t.Errorf("NewDecimal(55, 2) = %s", got)
+	}
+	if got := NewDecimal(3, -2).String(); got != "300" {
+		t.Errorf("NewDecimal(3, -2) = %s", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("NewDecimal with scale math.MinInt32 did not panic")
+		}
+	}()
+	NewDecimal(1, math.MinInt32)
+}
@@ -0,0 +1,164 @@
+package polymarket
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors for common API failures. They can be matched with errors.Is
+// against any error returned by the client.
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrRateLimited = errors.New("rate limited")
+	ErrServer      = errors.New("server error")
+	ErrBadRequest  = errors.New("bad request")
+
+	// ErrUnauthorized is matched by 401 and 403 responses
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrNoCredentials is returned by authenticated methods when the client
+	// has no API credentials; see WithL2Auth
+	ErrNoCredentials = errors.New("API credentials required")
+)
+
+// maxErrorSnippet caps how much of a response body is kept in errors
+const maxErrorSnippet = 512
+
+// APIError represents an error response from the Polymarket API
+type APIError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+
+	// Request details
+	Endpoint string `json:"-"` // API path, e.g. "/markets"
+	URL      string `json:"-"` // Full request URL including query
+
+	// Body is the beginning of the raw response body
+	Body string `json:"-"`
+
+	// RetryAfter is the delay requested by the server, if any
+	RetryAfter time.Duration `json:"-"`
+}
+
+// newAPIError builds an APIError from a non-200 response
+func newAPIError(status int, endpoint, fullURL string, body []byte, retryAfter time.Duration) *APIError {
+	apiErr := &APIError{
+		Code:       status,
+		Endpoint:   endpoint,
+		URL:        fullURL,
+		Body:       snippet(body, 0),
+		RetryAfter: retryAfter,
+	}
+
+	// The API reports errors as either {"message": ...} or {"error": ...}
+	var payload struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		apiErr.Message = payload.Message
+		if apiErr.Message == "" {
+			apiErr.Message = payload.Error
+		}
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API returned status %d", e.Code)
+	if e.Endpoint != "" {
+		msg += " for " + e.Endpoint
+	}
+	if e.Message != "" {
+		return msg + ": " + e.Message
+	}
+	if e.Body != "" {
+		return msg + ": " + e.Body
+	}
+	return msg
+}
+
+// Is matches the error against the sentinel errors based on its status code
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Code == http.StatusNotFound
+	case ErrRateLimited:
+		return e.Code == http.StatusTooManyRequests
+	case ErrServer:
+		return e.Code >= http.StatusInternalServerError
+	case ErrBadRequest:
+		return e.Code == http.StatusBadRequest || e.Code == http.StatusUnprocessableEntity
+	case ErrUnauthorized:
+		return e.Code == http.StatusUnauthorized || e.Code == http.StatusForbidden
+	}
+	return false
+}
+
+// DecodeError is returned when a response body cannot be decoded
+type DecodeError struct {
+	// Payload is an excerpt of the body around the point where decoding failed
+	Payload string
+	Err     error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%v (payload: %s)", e.Err, e.Payload)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// decodeJSON unmarshals body into v, returning a *DecodeError on failure
+func decodeJSON(body []byte, v interface{}) error {
+	err := json.Unmarshal(body, v)
+	if err == nil {
+		return nil
+	}
+
+	var offset int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	}
+
+	return &DecodeError{
+		Payload: snippet(body, int(offset)),
+		Err:     err,
+	}
+}
+
+// snippet returns at most maxErrorSnippet bytes of body centred on offset
+func snippet(body []byte, offset int) string {
+	if len(body) <= maxErrorSnippet {
+		return string(body)
+	}
+
+	start := offset - maxErrorSnippet/2
+	if start < 0 {
+		start = 0
+	}
+	end := start + maxErrorSnippet
+	if end > len(body) {
+		end = len(body)
+		start = end - maxErrorSnippet
+	}
+
+	excerpt := string(body[start:end])
+	if start > 0 {
+		excerpt = "..." + excerpt
+	}
+	if end < len(body) {
+		excerpt += "..."
+	}
+	return excerpt
+}
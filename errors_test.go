@@ -0,0 +1,22 @@
+package polymarket
+
+import "testing"
+
+func TestAPIErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *APIError
+		want string
+	}{
+		{"message and endpoint", &APIError{Code: 400, Message: "invalid limit", Endpoint: "/markets", Body: `{"message":"invalid limit"}`},
+			"API returned status 400 for /markets: invalid limit"},
+		{"message only", &APIError{Code: 404, Message: "market not found"}, "API returned status 404: market not found"},
+		{"body and endpoint", &APIError{Code: 502, Endpoint: "/events", Body: "Bad Gateway"}, "API returned status 502 for /events: Bad Gateway"},
+		{"status only", &APIError{Code: 503}, "API returned status 503"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("%s: Error = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -1,71 +1,96 @@
 package polymarket
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 )
 
 // GetEvents retrieves a list of events from the Polymarket API
 func (c *Client) GetEvents(params *EventsParams) ([]Event, error) {
-	body, err := c.makeRequest("GET", "/events", buildParams(params))
+	return c.GetEventsContext(context.Background(), params)
+}
+
+// GetEventsContext is like GetEvents but uses ctx for cancellation and deadlines
+func (c *Client) GetEventsContext(ctx context.Context, params *EventsParams) ([]Event, error) {
+	body, err := c.makeRequest(ctx, "GET", "/events", buildParams(params))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch events: %w", err)
 	}
-	
+
 	var events []Event
-	if err := json.Unmarshal(body, &events); err != nil {
+	if err := decodeJSON(body, &events); err != nil {
 		return nil, fmt.Errorf("failed to parse events response: %w", err)
 	}
-	
+
 	return events, nil
 }
 
 // GetEvent retrieves a specific event by its ID
 func (c *Client) GetEvent(eventID string) (*Event, error) {
-	return c.GetEventWithParams(eventID, nil)
+	return c.GetEventWithParamsContext(context.Background(), eventID, nil)
+}
+
+// GetEventContext is like GetEvent but uses ctx for cancellation and deadlines
+func (c *Client) GetEventContext(ctx context.Context, eventID string) (*Event, error) {
+	return c.GetEventWithParamsContext(ctx, eventID, nil)
 }
 
 // GetEventWithParams retrieves a specific event by its ID with optional parameters
 func (c *Client) GetEventWithParams(eventID string, params *GetEventParams) (*Event, error) {
+	return c.GetEventWithParamsContext(context.Background(), eventID, params)
+}
+
+// GetEventWithParamsContext is like GetEventWithParams but uses ctx for cancellation and deadlines
+func (c *Client) GetEventWithParamsContext(ctx context.Context, eventID string, params *GetEventParams) (*Event, error) {
 	endpoint := fmt.Sprintf("/events/%s", eventID)
-	
-	body, err := c.makeRequest("GET", endpoint, buildParams(params))
+
+	body, err := c.makeRequest(ctx, "GET", endpoint, buildParams(params))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch event %s: %w", eventID, err)
 	}
-	
+
 	var event Event
-	if err := json.Unmarshal(body, &event); err != nil {
+	if err := decodeJSON(body, &event); err != nil {
 		return nil, fmt.Errorf("failed to parse event response: %w", err)
 	}
-	
+
 	return &event, nil
 }
 
 // GetEventBySlug retrieves a specific event by its slug
 func (c *Client) GetEventBySlug(slug string) (*Event, error) {
+	return c.GetEventBySlugContext(context.Background(), slug)
+}
+
+// GetEventBySlugContext is like GetEventBySlug but uses ctx for cancellation and deadlines
+func (c *Client) GetEventBySlugContext(ctx context.Context, slug string) (*Event, error) {
 	params := &EventsParams{
 		Slug:  []string{slug},
 		Limit: 1,
 	}
-	
-	events, err := c.GetEvents(params)
+
+	events, err := c.GetEventsContext(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch event by slug %s: %w", slug, err)
 	}
-	
+
 	if len(events) == 0 {
-		return nil, fmt.Errorf("event with slug %s not found", slug)
+		return nil, fmt.Errorf("event with slug %s: %w", slug, ErrNotFound)
 	}
-	
+
 	return &events[0], nil
 }
 
 // GetEventMarkets retrieves all markets for a specific event
 func (c *Client) GetEventMarkets(eventID string) ([]Market, error) {
+	return c.GetEventMarketsContext(context.Background(), eventID)
+}
+
+// GetEventMarketsContext is like GetEventMarkets but uses ctx for cancellation and deadlines
+func (c *Client) GetEventMarketsContext(ctx context.Context, eventID string) ([]Market, error) {
 	params := &MarketsParams{
 		EventID: eventID,
 	}
-	
-	return c.GetMarkets(params)
-}
\ No newline at end of file
+
+	return c.GetMarketsContext(ctx, params)
+}
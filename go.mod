@@ -1,3 +1,11 @@
 module github.com/mathiasme/polymarket
 
 go 1.21
+
+require (
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.3.0
+	github.com/gorilla/websocket v1.5.3
+	golang.org/x/crypto v0.21.0
+)
+
+require golang.org/x/sys v0.18.0 // indirect
@@ -0,0 +1,152 @@
+package polymarket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// concentrationPlaces is the precision of computed concentration shares
+const concentrationPlaces = 8
+
+// GetOpenInterest retrieves the open interest of the given markets (condition IDs)
+func (c *Client) GetOpenInterest(markets []string) ([]OpenInterest, error) {
+	return c.GetOpenInterestContext(context.Background(), markets)
+}
+
+// GetOpenInterestContext is like GetOpenInterest but uses ctx for cancellation and deadlines
+func (c *Client) GetOpenInterestContext(ctx context.Context, markets []string) ([]OpenInterest, error) {
+	if len(markets) == 0 {
+		return nil, fmt.Errorf("at least one market condition ID is required")
+	}
+
+	params := url.Values{}
+	params.Add("market", strings.Join(markets, ","))
+
+	body, err := c.makeRequestWithBaseURL(ctx, c.dataAPIBaseURL, "GET", "/oi", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open interest: %w", err)
+	}
+
+	var interest []OpenInterest
+	if err := decodeJSON(body, &interest); err != nil {
+		return nil, fmt.Errorf("failed to parse open interest response: %w", err)
+	}
+
+	return interest, nil
+}
+
+// GetEventOpenInterest retrieves the total open interest across all markets of an event
+func (c *Client) GetEventOpenInterest(eventID string) (Decimal, error) {
+	return c.GetEventOpenInterestContext(context.Background(), eventID)
+}
+
+// GetEventOpenInterestContext is like GetEventOpenInterest but uses ctx for cancellation and deadlines
+func (c *Client) GetEventOpenInterestContext(ctx context.Context, eventID string) (Decimal, error) {
+	event, err := c.GetEventContext(ctx, eventID)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	var markets []string
+	for _, market := range event.Markets {
+		if market.ConditionID != "" {
+			markets = append(markets, market.ConditionID)
+		}
+	}
+	if len(markets) == 0 {
+		return Decimal{}, nil
+	}
+
+	interest, err := c.GetOpenInterestContext(ctx, markets)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	var total Decimal
+	for _, oi := range interest {
+		total = total.Add(oi.Value)
+	}
+
+	return total, nil
+}
+
+// GetTokenHolders retrieves the top holders of a single outcome token of a market
+func (c *Client) GetTokenHolders(conditionID, tokenID string, limit int) ([]Holder, error) {
+	return c.GetTokenHoldersContext(context.Background(), conditionID, tokenID, limit)
+}
+
+// GetTokenHoldersContext is like GetTokenHolders but uses ctx for cancellation and deadlines
+func (c *Client) GetTokenHoldersContext(ctx context.Context, conditionID, tokenID string, limit int) ([]Holder, error) {
+	holders, err := c.GetHoldersContext(ctx, &HoldersParams{
+		Market: []string{conditionID},
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range holders {
+		if token.Token == tokenID {
+			return token.Holders, nil
+		}
+	}
+
+	return nil, fmt.Errorf("holders of token %s: %w", tokenID, ErrNotFound)
+}
+
+// HolderConcentration summarizes how concentrated a token's holdings are
+type HolderConcentration struct {
+	Holders     int     // Number of holders considered
+	TotalAmount Decimal // Sum of all holder amounts
+	TopN        int     // Number of largest holders in TopNAmount
+	TopNAmount  Decimal // Amount held by the TopN largest holders
+	TopNShare   Decimal // TopNAmount / TotalAmount, between 0 and 1
+	Herfindahl  Decimal // Sum of squared shares, between 0 and 1
+}
+
+// ComputeHolderConcentration computes the top-N share and Herfindahl index of
+// a holder list. The Data API only returns the largest holders, so the
+// result describes concentration among those holders.
+func ComputeHolderConcentration(holders []Holder, topN int) HolderConcentration {
+	amounts := make([]Decimal, 0, len(holders))
+	var total Decimal
+	for _, holder := range holders {
+		if holder.Amount.Sign() <= 0 {
+			continue
+		}
+		amounts = append(amounts, holder.Amount)
+		total = total.Add(holder.Amount)
+	}
+
+	sort.Slice(amounts, func(i, j int) bool {
+		return amounts[i].GreaterThan(amounts[j])
+	})
+
+	if topN > len(amounts) || topN < 0 {
+		topN = len(amounts)
+	}
+
+	result := HolderConcentration{
+		Holders:     len(amounts),
+		TotalAmount: total,
+		TopN:        topN,
+	}
+	if total.IsZero() {
+		return result
+	}
+
+	for i, amount := range amounts {
+		share := amount.Div(total, concentrationPlaces)
+		result.Herfindahl = result.Herfindahl.Add(share.Mul(share))
+		if i < topN {
+			result.TopNAmount = result.TopNAmount.Add(amount)
+		}
+	}
+	result.TopNShare = result.TopNAmount.Div(total, concentrationPlaces)
+	result.Herfindahl = result.Herfindahl.Round(concentrationPlaces)
+
+	return result
+}
@@ -0,0 +1,144 @@
+package polymarket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// testHolders builds holders from amounts
+func testHolders(amounts ...string) []Holder {
+	holders := make([]Holder, len(amounts))
+	for i, amount := range amounts {
+		holders[i] = Holder{Amount: MustParseDecimal(amount)}
+	}
+	return holders
+}
+
+func TestComputeHolderConcentration(t *testing.T) {
+	tests := []struct {
+		name    string
+		holders []Holder
+		topN    int
+
+		wantHolders, wantTopN                         int
+		wantTotal, wantTopNAmount, wantShare, wantHHI string
+	}{
+		{"top holder of three", testHolders("30", "50", "20"), 1,
+			3, 1, "100", "50", "0.5", "0.38"},
+		{"top two of three", testHolders("30", "50", "20"), 2,
+			3, 2, "100", "80", "0.8", "0.38"},
+		{"N larger than the holder count", testHolders("30", "50", "20"), 10,
+			3, 3, "100", "100", "1", "0.38"},
+		{"negative N counts every holder", testHolders("30", "50", "20"), -1,
+			3, 3, "100", "100", "1", "0.38"},
+		{"single holder", testHolders("7.5"), 1,
+			1, 1, "7.5", "7.5", "1", "1"},
+		{"equal holders round to 8 places", testHolders("1", "1", "1"), 2,
+			3, 2, "3", "2", "0.66666667", "0.33333333"},
+		{"non-positive amounts are ignored", testHolders("0", "-5", "10", "10"), 1,
+			2, 1, "20", "10", "0.5", "0.5"},
+		{"zero total supply", testHolders("0", "0"), 1,
+			0, 0, "0", "0", "0", "0"},
+		{"no holders", nil, 5,
+			0, 0, "0", "0", "0", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ComputeHolderConcentration(tt.holders, tt.topN)
+
+			if got.Holders != tt.wantHolders || got.TopN != tt.wantTopN {
+				t.Errorf("Holders %d, TopN %d; want %d, %d", got.Holders, got.TopN, tt.wantHolders, tt.wantTopN)
+			}
+			decimals := []struct {
+				name      string
+				got, want Decimal
+			}{
+				{"TotalAmount", got.TotalAmount, MustParseDecimal(tt.wantTotal)},
+				{"TopNAmount", got.TopNAmount, MustParseDecimal(tt.wantTopNAmount)},
+				{"TopNShare", got.TopNShare, MustParseDecimal(tt.wantShare)},
+				{"Herfindahl", got.Herfindahl, MustParseDecimal(tt.wantHHI)},
+			}
+			for _, d := range decimals {
+				if !d.got.Equal(d.want) {
+					t.Errorf("%s = %s, want %s", d.name, d.got, d.want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetTokenHolders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/holders" || r.URL.RawQuery != "limit=5&market=0xc" {
+			t.Errorf("request = %s, want /holders?limit=5&market=0xc", r.URL)
+		}
+		w.Write([]byte(`[
+			{"token":"111","holders":[{"proxyWallet":"0x1","amount":"10"}]},
+			{"token":"222","holders":[{"proxyWallet":"0x2","amount":"20.5","outcomeIndex":1},{"proxyWallet":"0x3","amount":"3"}]}
+		]`))
+	}))
+	defer srv.Close()
+	c := NewClient(WithDataAPIBaseURL(srv.URL))
+
+	holders, err := c.GetTokenHoldersContext(context.Background(), "0xc", "222", 5)
+	if err != nil {
+		t.Fatalf("GetTokenHolders: %v", err)
+	}
+	if len(holders) != 2 || holders[0].ProxyWallet != "0x2" || !holders[0].Amount.Equal(MustParseDecimal("20.5")) || holders[0].OutcomeIndex != 1 {
+		t.Errorf("holders = %+v, want the holders of token 222", holders)
+	}
+
+	if _, err := c.GetTokenHoldersContext(context.Background(), "0xc", "333", 5); !errors.Is(err, ErrNotFound) {
+		t.Errorf("missing token: error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestGetEventOpenInterest(t *testing.T) {
+	gamma := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/events/7":
+			w.Write([]byte(`{"id":"7","markets":[{"id":"1","conditionId":"0xa"},{"id":"2","conditionId":""},{"id":"3","conditionId":"0xb"}]}`))
+		case "/events/8":
+			w.Write([]byte(`{"id":"8","markets":[]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer gamma.Close()
+
+	var oiRequests int32
+	data := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&oiRequests, 1)
+		if r.URL.Path != "/oi" || r.URL.Query().Get("market") != "0xa,0xb" {
+			t.Errorf("request = %s, want /oi?market=0xa,0xb", r.URL)
+		}
+		w.Write([]byte(`[{"market":"0xa","value":"100.5"},{"market":"0xb","value":"200.25"}]`))
+	}))
+	defer data.Close()
+
+	c := NewClient(WithBaseURL(gamma.URL), WithDataAPIBaseURL(data.URL))
+
+	total, err := c.GetEventOpenInterestContext(context.Background(), "7")
+	if err != nil {
+		t.Fatalf("GetEventOpenInterest: %v", err)
+	}
+	if !total.Equal(MustParseDecimal("300.75")) {
+		t.Errorf("total = %s, want 300.75", total)
+	}
+
+	total, err = c.GetEventOpenInterestContext(context.Background(), "8")
+	if err != nil || !total.IsZero() {
+		t.Errorf("event without markets = %s, %v; want 0, nil", total, err)
+	}
+	if got := atomic.LoadInt32(&oiRequests); got != 1 {
+		t.Errorf("/oi requests = %d, want 1", got)
+	}
+
+	if _, err := c.GetEventOpenInterestContext(context.Background(), "9"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("missing event: error = %v, want ErrNotFound", err)
+	}
+}
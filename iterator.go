@@ -0,0 +1,227 @@
+package polymarket
+
+import (
+	"context"
+)
+
+// defaultPageSize is used by iterators when the params don't set a Limit
+const defaultPageSize = 100
+
+// Iterator walks a paginated result set one item at a time, fetching
+// further pages as needed. It stops on an empty page, when the API reports
+// no more results, when the optional item cap is reached, or when its
+// context is done.
+//
+//	it := client.MarketsIterator(ctx, &polymarket.MarketsParams{Active: &active})
+//	for it.Next() {
+//		market := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, page int) (items []T, more bool, err error)
+
+	buf      []T
+	cur      T
+	page     int
+	done     bool
+	err      error
+	maxItems int
+	count    int
+}
+
+// MarketIterator iterates over markets
+type MarketIterator = Iterator[Market]
+
+// EventIterator iterates over events
+type EventIterator = Iterator[Event]
+
+// CommentIterator iterates over comments
+type CommentIterator = Iterator[Comment]
+
+// SearchIterator iterates over search hits
+type SearchIterator = Iterator[SearchHit]
+
+// SearchHit is a single search result: exactly one of Event, Tag and
+// Profile is set
+type SearchHit struct {
+	Event   *Event
+	Tag     *Tag
+	Profile *UserProfile
+}
+
+// newIterator creates an iterator calling fetch for each zero-based page
+func newIterator[T any](ctx context.Context, fetch func(ctx context.Context, page int) ([]T, bool, error)) *Iterator[T] {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &Iterator[T]{ctx: ctx, fetch: fetch}
+}
+
+// MaxItems caps the total number of items the iterator yields.
+// Zero means no cap. It returns the iterator for chaining.
+func (it *Iterator[T]) MaxItems(n int) *Iterator[T] {
+	it.maxItems = n
+	return it
+}
+
+// Next advances to the next item, fetching the next page if needed.
+// It returns false when iteration is over or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.maxItems > 0 && it.count >= it.maxItems {
+		return false
+	}
+
+	for len(it.buf) == 0 {
+		if it.done {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		items, more, err := it.fetch(it.ctx, it.page)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page++
+		it.buf = items
+		if !more || len(items) == 0 {
+			it.done = true
+		}
+	}
+
+	it.cur = it.buf[0]
+	it.buf = it.buf[1:]
+	it.count++
+	return true
+}
+
+// Value returns the current item
+func (it *Iterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the first error encountered during iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All drains the iterator into a slice
+func (it *Iterator[T]) All() ([]T, error) {
+	var items []T
+	for it.Next() {
+		items = append(items, it.Value())
+	}
+	return items, it.Err()
+}
+
+// MarketsIterator returns an iterator over all markets matching params,
+// advancing Offset by the number of markets each page returned, so a server
+// capping pages below Limit is walked in full. params is not modified.
+func (c *Client) MarketsIterator(ctx context.Context, params *MarketsParams) *MarketIterator {
+	p := MarketsParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.Limit <= 0 {
+		p.Limit = defaultPageSize
+	}
+	offset := p.Offset
+
+	return newIterator(ctx, func(ctx context.Context, page int) ([]Market, bool, error) {
+		pageParams := p
+		pageParams.Offset = offset
+		markets, err := c.GetMarketsContext(ctx, &pageParams)
+		offset += len(markets)
+		return markets, true, err
+	})
+}
+
+// EventsIterator returns an iterator over all events matching params,
+// advancing Offset by the number of events each page returned, so a server
+// capping pages below Limit is walked in full. params is not modified.
+func (c *Client) EventsIterator(ctx context.Context, params *EventsParams) *EventIterator {
+	p := EventsParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.Limit <= 0 {
+		p.Limit = defaultPageSize
+	}
+	offset := p.Offset
+
+	return newIterator(ctx, func(ctx context.Context, page int) ([]Event, bool, error) {
+		pageParams := p
+		pageParams.Offset = offset
+		events, err := c.GetEventsContext(ctx, &pageParams)
+		offset += len(events)
+		return events, true, err
+	})
+}
+
+// CommentsIterator returns an iterator over all comments matching params,
+// advancing Offset by the number of comments each page returned, so a server
+// capping pages below Limit is walked in full. params is not modified.
+func (c *Client) CommentsIterator(ctx context.Context, params *CommentsParams) *CommentIterator {
+	p := CommentsParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.Limit <= 0 {
+		p.Limit = defaultPageSize
+	}
+	offset := p.Offset
+
+	return newIterator(ctx, func(ctx context.Context, page int) ([]Comment, bool, error) {
+		pageParams := p
+		pageParams.Offset = offset
+		comments, err := c.GetCommentsContext(ctx, &pageParams)
+		offset += len(comments)
+		return comments, true, err
+	})
+}
+
+// SearchIterator returns an iterator over the events, tags and profiles of
+// all search result pages, in that order within each page, advancing Page
+// until Pagination.HasMore is false or a page is empty. Each hit counts as
+// one item for MaxItems. params is not modified.
+func (c *Client) SearchIterator(ctx context.Context, params *SearchParams) *SearchIterator {
+	p := SearchParams{}
+	if params != nil {
+		p = *params
+	}
+	start := p.Page
+	if start < 1 {
+		start = 1
+	}
+
+	return newIterator(ctx, func(ctx context.Context, page int) ([]SearchHit, bool, error) {
+		pageParams := p
+		pageParams.Page = start + page
+		results, err := c.SearchContext(ctx, &pageParams)
+		if err != nil {
+			return nil, false, err
+		}
+
+		hits := make([]SearchHit, 0, len(results.Events)+len(results.Tags)+len(results.Profiles))
+		for i := range results.Events {
+			hits = append(hits, SearchHit{Event: &results.Events[i]})
+		}
+		for i := range results.Tags {
+			hits = append(hits, SearchHit{Tag: &results.Tags[i]})
+		}
+		for i := range results.Profiles {
+			hits = append(hits, SearchHit{Profile: &results.Profiles[i]})
+		}
+		return hits, results.Pagination.HasMore, nil
+	})
+}
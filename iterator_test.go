@@ -0,0 +1,254 @@
+package polymarket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// marketPages serves /markets from a function returning the market IDs of
+// the page at offset/limit, and counts the requests it receives
+func marketPages(t *testing.T, page func(w http.ResponseWriter, r *http.Request, offset, limit int) []string) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		ids := page(w, r, offset, limit)
+		if ids == nil {
+			return // the handler wrote its own response
+		}
+		markets := make([]map[string]string, len(ids))
+		for i, id := range ids {
+			markets[i] = map[string]string{"id": id}
+		}
+		json.NewEncoder(w).Encode(markets)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+// sequentialIDs returns the IDs of a catalog of total items numbered from 0
+func sequentialIDs(total int) func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+	return func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		ids := []string{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			ids = append(ids, strconv.Itoa(i))
+		}
+		return ids
+	}
+}
+
+// marketIDs returns the IDs of markets in order
+func marketIDs(markets []Market) []string {
+	ids := make([]string, len(markets))
+	for i, m := range markets {
+		ids[i] = m.ID
+	}
+	return ids
+}
+
+// checkSequentialIDs fails unless ids are "0", "1", ... up to n-1
+func checkSequentialIDs(t *testing.T, ids []string, n int) {
+	t.Helper()
+
+	if len(ids) != n {
+		t.Fatalf("got %d items, want %d", len(ids), n)
+	}
+	for i, id := range ids {
+		if id != strconv.Itoa(i) {
+			t.Fatalf("item %d has ID %s, want %d", i, id, i)
+		}
+	}
+}
+
+func TestIteratorWalksPagesUntilEmptyPage(t *testing.T) {
+	srv, requests := marketPages(t, sequentialIDs(25))
+	c := NewClient(WithBaseURL(srv.URL))
+
+	markets, err := c.MarketsIterator(context.Background(), &MarketsParams{Limit: 10}).All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	checkSequentialIDs(t, marketIDs(markets), 25)
+	if got := atomic.LoadInt32(requests); got != 4 {
+		t.Errorf("requests = %d, want 4", got)
+	}
+}
+
+func TestIteratorLimitAboveServerCap(t *testing.T) {
+	srv, _ := marketPages(t, func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		if limit > 10 {
+			limit = 10
+		}
+		return sequentialIDs(25)(w, r, offset, limit)
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	markets, err := c.MarketsIterator(context.Background(), &MarketsParams{Limit: 50}).All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	checkSequentialIDs(t, marketIDs(markets), 25)
+}
+
+func TestIteratorMaxItems(t *testing.T) {
+	srv, requests := marketPages(t, sequentialIDs(100))
+	c := NewClient(WithBaseURL(srv.URL))
+
+	markets, err := c.MarketsIterator(context.Background(), &MarketsParams{Limit: 10}).MaxItems(15).All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	checkSequentialIDs(t, marketIDs(markets), 15)
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestIteratorContextCancellation(t *testing.T) {
+	srv, requests := marketPages(t, sequentialIDs(100))
+	c := NewClient(WithBaseURL(srv.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	it := c.MarketsIterator(ctx, &MarketsParams{Limit: 10})
+
+	count := 0
+	for it.Next() {
+		count++
+		if count == 10 {
+			cancel()
+		}
+	}
+
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Fatalf("Err = %v, want context.Canceled", it.Err())
+	}
+	if count != 10 {
+		t.Errorf("yielded %d items, want the 10 of the first page", count)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestIteratorStopsOnError(t *testing.T) {
+	srv, requests := marketPages(t, func(w http.ResponseWriter, r *http.Request, offset, limit int) []string {
+		if offset > 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		return sequentialIDs(100)(w, r, offset, limit)
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	it := c.MarketsIterator(context.Background(), &MarketsParams{Limit: 10})
+	count := 0
+	for it.Next() {
+		count++
+	}
+
+	if !errors.Is(it.Err(), ErrServer) {
+		t.Fatalf("Err = %v, want ErrServer", it.Err())
+	}
+	if count != 10 {
+		t.Errorf("yielded %d items, want 10", count)
+	}
+	if it.Next() {
+		t.Error("Next returned true after an error")
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+// searchPages serves /public-search with one event, tag and profile per page
+// and reports more pages until the last one
+func searchPages(t *testing.T, last int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		id := strconv.Itoa(page)
+		fmt.Fprintf(w, `{"events":[{"id":"e%s"}],"tags":[{"id":"t%s"}],"profiles":[{"id":"p%s"}],"pagination":{"hasMore":%v}}`,
+			id, id, id, page < last)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+// searchHitNames describes hits as "e1", "t1", "p1", ...
+func searchHitNames(hits []SearchHit) []string {
+	names := make([]string, len(hits))
+	for i, h := range hits {
+		switch {
+		case h.Event != nil:
+			names[i] = h.Event.ID
+		case h.Tag != nil:
+			names[i] = h.Tag.ID
+		case h.Profile != nil:
+			names[i] = h.Profile.ID
+		}
+	}
+	return names
+}
+
+func TestSearchIteratorYieldsHits(t *testing.T) {
+	srv, requests := searchPages(t, 2)
+	c := NewClient(WithBaseURL(srv.URL))
+
+	hits, err := c.SearchIterator(context.Background(), &SearchParams{Q: "election"}).All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	want := []string{"e1", "t1", "p1", "e2", "t2", "p2"}
+	if got := searchHitNames(hits); !reflect.DeepEqual(got, want) {
+		t.Errorf("hits = %v, want %v", got, want)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestSearchIteratorMaxItemsCountsHits(t *testing.T) {
+	srv, requests := searchPages(t, 10)
+	c := NewClient(WithBaseURL(srv.URL))
+
+	hits, err := c.SearchIterator(context.Background(), &SearchParams{Q: "election"}).MaxItems(4).All()
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	want := []string{"e1", "t1", "p1", "e2"}
+	if got := searchHitNames(hits); !reflect.DeepEqual(got, want) {
+		t.Errorf("hits = %v, want %v", got, want)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestSearchIteratorStopsOnEmptyPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"events":[],"pagination":{"hasMore":true}}`))
+	}))
+	defer srv.Close()
+	c := NewClient(WithBaseURL(srv.URL))
+
+	hits, err := c.SearchIterator(context.Background(), &SearchParams{Q: "election"}).All()
+	if err != nil || len(hits) != 0 {
+		t.Errorf("All = %v, %v; want no hits", hits, err)
+	}
+}
@@ -16,10 +16,10 @@ func Test() {
 	marketsParams := &MarketsParams{
 		Limit:     5,
 		Offset:    0,
-		Ascending: true,
+		Ascending: boolPtr(true),
 		Active:    boolPtr(true),
 	}
-	log.Println("Raw : ", marketsParams.Ascending)
+	log.Println("Ascending: ", *marketsParams.Ascending)
 
 	markets, err := client.GetMarkets(marketsParams)
 	if err != nil {
@@ -112,11 +112,11 @@ func Test() {
 			if err != nil {
 				log.Printf("Error fetching live volume: %v", err)
 			} else {
-				log.Printf("Total volume: %.2f", volume.Total)
+				log.Printf("Total volume: %s", volume.Total.StringFixed(2))
 				log.Printf("Markets with volume: %d", len(volume.Markets))
 				for i, market := range volume.Markets {
 					if i < 3 { // Show only first 3 markets
-						log.Printf("  Market %s: %.2f", market.Market[:16]+"...", market.Value)
+						log.Printf("  Market %s: %s", market.Market[:16]+"...", market.Value.StringFixed(2))
 					}
 				}
 			}
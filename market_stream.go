@@ -0,0 +1,469 @@
+package polymarket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Market channel event types
+const (
+	MarketEventBook           = "book"
+	MarketEventPriceChange    = "price_change"
+	MarketEventTickSizeChange = "tick_size_change"
+	MarketEventLastTradePrice = "last_trade_price"
+)
+
+// MarketEvent is an event received on the CLOB market channel. It is one of
+// *BookEvent, *PriceChangeEvent, *TickSizeChangeEvent or *LastTradePriceEvent.
+type MarketEvent interface {
+	EventType() string
+}
+
+// BookEvent is a full order book snapshot of a token
+type BookEvent struct {
+	AssetID   string       `json:"asset_id"`
+	Market    string       `json:"market"`
+	Bids      []PriceLevel `json:"bids"`
+	Asks      []PriceLevel `json:"asks"`
+	Hash      string       `json:"hash"`
+	Timestamp string       `json:"timestamp"` // Unix milliseconds
+}
+
+// EventType returns MarketEventBook
+func (e *BookEvent) EventType() string { return MarketEventBook }
+
+// UnmarshalJSON accepts both the "bids"/"asks" and the older "buys"/"sells" fields
+func (e *BookEvent) UnmarshalJSON(data []byte) error {
+	type bookEventAlias BookEvent
+	var aux struct {
+		bookEventAlias
+		Buys  []PriceLevel `json:"buys"`
+		Sells []PriceLevel `json:"sells"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	*e = BookEvent(aux.bookEventAlias)
+	if e.Bids == nil {
+		e.Bids = aux.Buys
+	}
+	if e.Asks == nil {
+		e.Asks = aux.Sells
+	}
+	return nil
+}
+
+// PriceChange is a new aggregate size at one price level of a token's book.
+// A zero Size removes the level.
+type PriceChange struct {
+	AssetID string  `json:"asset_id"`
+	Price   Decimal `json:"price"`
+	Size    Decimal `json:"size"`
+	Side    Side    `json:"side"` // SideBuy for bids, SideSell for asks
+	Hash    string  `json:"hash"`
+	BestBid Decimal `json:"best_bid"`
+	BestAsk Decimal `json:"best_ask"`
+}
+
+// PriceChangeEvent is a set of order book level updates
+type PriceChangeEvent struct {
+	Market    string        `json:"market"`
+	Changes   []PriceChange `json:"price_changes"`
+	Timestamp string        `json:"timestamp"` // Unix milliseconds
+}
+
+// EventType returns MarketEventPriceChange
+func (e *PriceChangeEvent) EventType() string { return MarketEventPriceChange }
+
+// UnmarshalJSON accepts both the "price_changes" format and the older
+// single-asset format with "asset_id" and "changes" fields
+func (e *PriceChangeEvent) UnmarshalJSON(data []byte) error {
+	type priceChangeEventAlias PriceChangeEvent
+	var aux struct {
+		priceChangeEventAlias
+		AssetID string        `json:"asset_id"`
+		Hash    string        `json:"hash"`
+		Legacy  []PriceChange `json:"changes"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	*e = PriceChangeEvent(aux.priceChangeEventAlias)
+	if e.Changes == nil {
+		for _, change := range aux.Legacy {
+			if change.AssetID == "" {
+				change.AssetID = aux.AssetID
+			}
+			if change.Hash == "" {
+				change.Hash = aux.Hash
+			}
+			e.Changes = append(e.Changes, change)
+		}
+	}
+	return nil
+}
+
+// TickSizeChangeEvent reports a change of a token's minimum tick size
+type TickSizeChangeEvent struct {
+	AssetID     string  `json:"asset_id"`
+	Market      string  `json:"market"`
+	OldTickSize Decimal `json:"old_tick_size"`
+	NewTickSize Decimal `json:"new_tick_size"`
+	Timestamp   string  `json:"timestamp"` // Unix milliseconds
+}
+
+// EventType returns MarketEventTickSizeChange
+func (e *TickSizeChangeEvent) EventType() string { return MarketEventTickSizeChange }
+
+// LastTradePriceEvent reports a trade of a token
+type LastTradePriceEvent struct {
+	AssetID    string  `json:"asset_id"`
+	Market     string  `json:"market"`
+	Price      Decimal `json:"price"`
+	Size       Decimal `json:"size"`
+	Side       Side    `json:"side"`
+	FeeRateBps Decimal `json:"fee_rate_bps"`
+	Timestamp  string  `json:"timestamp"` // Unix milliseconds
+}
+
+// EventType returns MarketEventLastTradePrice
+func (e *LastTradePriceEvent) EventType() string { return MarketEventLastTradePrice }
+
+// MarketStream is a live subscription to the CLOB market channel.
+// It reconnects automatically and keeps a local order book for every
+// subscribed token, updated before each event is delivered.
+type MarketStream struct {
+	session *wsSession
+	events  chan MarketEvent
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	mu     sync.Mutex
+	assets map[string]bool
+	books  map[string]*localBook
+	err    error
+
+	// tickSizes outlives the books, which are dropped on reconnection
+	tickSizes map[string]Decimal
+}
+
+// SubscribeMarket connects to the CLOB market channel and subscribes to the
+// given token IDs. The stream runs until Close is called.
+func (c *Client) SubscribeMarket(tokenIDs []string, opts *StreamOptions) (*MarketStream, error) {
+	return c.SubscribeMarketContext(context.Background(), tokenIDs, opts)
+}
+
+// SubscribeMarketContext is like SubscribeMarket but uses ctx for the
+// initial connection and the lifetime of the stream
+func (c *Client) SubscribeMarketContext(ctx context.Context, tokenIDs []string, opts *StreamOptions) (*MarketStream, error) {
+	if len(tokenIDs) == 0 {
+		return nil, fmt.Errorf("at least one token ID is required")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &MarketStream{
+		session:   c.newWSSession(c.wsBaseURL+"/market", opts),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		assets:    make(map[string]bool),
+		books:     make(map[string]*localBook),
+		tickSizes: make(map[string]Decimal),
+	}
+	s.events = make(chan MarketEvent, s.session.opts.BufferSize)
+	for _, id := range tokenIDs {
+		s.assets[id] = true
+	}
+
+	s.session.subscribe = func() interface{} {
+		return map[string]interface{}{
+			"assets_ids": s.assetIDs(),
+			"type":       "market",
+		}
+	}
+	s.session.onConnect = s.resetBooks
+	s.session.onMessage = func(data []byte) {
+		s.handleMessage(ctx, data)
+	}
+
+	conn, err := s.session.dial(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe to market channel: %w", err)
+	}
+
+	go func() {
+		err := s.session.run(ctx, conn)
+
+		s.mu.Lock()
+		if !errors.Is(err, context.Canceled) {
+			s.err = err
+		}
+		s.mu.Unlock()
+
+		close(s.events)
+		close(s.done)
+	}()
+
+	return s, nil
+}
+
+// Events returns the channel of market events. It is closed when the stream ends.
+func (s *MarketStream) Events() <-chan MarketEvent {
+	return s.events
+}
+
+// Subscribe adds token IDs to the subscription
+func (s *MarketStream) Subscribe(tokenIDs ...string) error {
+	s.mu.Lock()
+	for _, id := range tokenIDs {
+		s.assets[id] = true
+	}
+	s.mu.Unlock()
+
+	return s.update("subscribe", tokenIDs)
+}
+
+// Unsubscribe removes token IDs from the subscription and drops their local books
+func (s *MarketStream) Unsubscribe(tokenIDs ...string) error {
+	s.mu.Lock()
+	for _, id := range tokenIDs {
+		delete(s.assets, id)
+		delete(s.books, id)
+		delete(s.tickSizes, id)
+	}
+	s.mu.Unlock()
+
+	return s.update("unsubscribe", tokenIDs)
+}
+
+// update sends a subscription change. While the stream is reconnecting the
+// change is applied by the next subscription instead.
+func (s *MarketStream) update(operation string, tokenIDs []string) error {
+	err := s.session.writeJSON(map[string]interface{}{
+		"assets_ids": tokenIDs,
+		"operation":  operation,
+	})
+	if errors.Is(err, errNotConnected) {
+		return nil
+	}
+	return err
+}
+
+// Book returns a snapshot of the local order book of a token, with bids
+// sorted from highest to lowest and asks from lowest to highest.
+// It reports false until a book snapshot has been received for the token.
+func (s *MarketStream) Book(tokenID string) (*OrderBook, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	book, ok := s.books[tokenID]
+	if !ok {
+		return nil, false
+	}
+	return book.snapshot(tokenID), true
+}
+
+// Close ends the stream and waits for it to shut down
+func (s *MarketStream) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// Err returns the error that ended the stream, or nil if it was closed
+func (s *MarketStream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// assetIDs returns the subscribed token IDs in a stable order
+func (s *MarketStream) assetIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(s.assets))
+	for id := range s.assets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// resetBooks drops the local books; the server sends fresh snapshots on
+// subscription. Known tick sizes are kept for the new books.
+func (s *MarketStream) resetBooks() {
+	s.mu.Lock()
+	s.books = make(map[string]*localBook)
+	s.mu.Unlock()
+}
+
+// handleMessage decodes a channel message, applies it to the local books and
+// delivers its events
+func (s *MarketStream) handleMessage(ctx context.Context, data []byte) {
+	messages, err := splitMessages(data)
+	if err != nil {
+		s.session.client.logDebug("polymarket market stream: invalid message", "error", err)
+		return
+	}
+
+	for _, message := range messages {
+		event, err := decodeMarketEvent(message)
+		if err != nil {
+			s.session.client.logDebug("polymarket market stream: invalid event", "error", err)
+			continue
+		}
+		if event == nil {
+			continue
+		}
+
+		s.apply(event)
+
+		select {
+		case s.events <- event:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// apply updates the local books from an event
+func (s *MarketStream) apply(event MarketEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch e := event.(type) {
+	case *BookEvent:
+		if !s.assets[e.AssetID] {
+			return
+		}
+		book := newLocalBook()
+		book.tickSize = s.tickSizes[e.AssetID]
+		book.market, book.hash, book.timestamp = e.Market, e.Hash, e.Timestamp
+		for _, level := range e.Bids {
+			book.set(SideBuy, level.Price, level.Size)
+		}
+		for _, level := range e.Asks {
+			book.set(SideSell, level.Price, level.Size)
+		}
+		s.books[e.AssetID] = book
+
+	case *PriceChangeEvent:
+		for _, change := range e.Changes {
+			book, ok := s.books[change.AssetID]
+			if !ok {
+				continue
+			}
+			book.set(change.Side, change.Price, change.Size)
+			book.timestamp = e.Timestamp
+			if change.Hash != "" {
+				book.hash = change.Hash
+			}
+		}
+
+	case *TickSizeChangeEvent:
+		if !s.assets[e.AssetID] {
+			return
+		}
+		s.tickSizes[e.AssetID] = e.NewTickSize
+		if book, ok := s.books[e.AssetID]; ok {
+			book.tickSize = e.NewTickSize
+		}
+	}
+}
+
+// decodeMarketEvent decodes a single market channel event. Unknown event
+// types decode to nil.
+func decodeMarketEvent(data []byte) (MarketEvent, error) {
+	var header struct {
+		EventType string `json:"event_type"`
+	}
+	if err := decodeJSON(data, &header); err != nil {
+		return nil, err
+	}
+
+	var event MarketEvent
+	switch header.EventType {
+	case MarketEventBook:
+		event = &BookEvent{}
+	case MarketEventPriceChange:
+		event = &PriceChangeEvent{}
+	case MarketEventTickSizeChange:
+		event = &TickSizeChangeEvent{}
+	case MarketEventLastTradePrice:
+		event = &LastTradePriceEvent{}
+	default:
+		return nil, nil
+	}
+
+	if err := decodeJSON(data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// localBook is an order book maintained from market channel events.
+// Levels are keyed by their normalized price string.
+type localBook struct {
+	market    string
+	hash      string
+	timestamp string
+	tickSize  Decimal
+	bids      map[string]PriceLevel
+	asks      map[string]PriceLevel
+}
+
+func newLocalBook() *localBook {
+	return &localBook{
+		bids: make(map[string]PriceLevel),
+		asks: make(map[string]PriceLevel),
+	}
+}
+
+// set replaces the size of a price level, removing it when size is zero
+func (b *localBook) set(side Side, price, size Decimal) {
+	levels := b.bids
+	if side == SideSell {
+		levels = b.asks
+	}
+
+	key := price.String()
+	if size.Sign() <= 0 {
+		delete(levels, key)
+		return
+	}
+	levels[key] = PriceLevel{Price: price, Size: size}
+}
+
+// snapshot copies the book into a sorted OrderBook
+func (b *localBook) snapshot(assetID string) *OrderBook {
+	book := &OrderBook{
+		Market:    b.market,
+		AssetID:   assetID,
+		Hash:      b.hash,
+		Timestamp: b.timestamp,
+		Bids:      make([]PriceLevel, 0, len(b.bids)),
+		Asks:      make([]PriceLevel, 0, len(b.asks)),
+		TickSize:  b.tickSize,
+	}
+	for _, level := range b.bids {
+		book.Bids = append(book.Bids, level)
+	}
+	for _, level := range b.asks {
+		book.Asks = append(book.Asks, level)
+	}
+
+	sort.Slice(book.Bids, func(i, j int) bool {
+		return book.Bids[i].Price.GreaterThan(book.Bids[j].Price)
+	})
+	sort.Slice(book.Asks, func(i, j int) bool {
+		return book.Asks[i].Price.LessThan(book.Asks[j].Price)
+	})
+	return book
+}
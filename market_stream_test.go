@@ -0,0 +1,332 @@
+package polymarket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsServer accepts WebSocket connections on /ws/market and hands each one to
+// the test. It returns the base URL to pass to WithWebSocketURL.
+func wsServer(t *testing.T) (string, <-chan *websocket.Conn) {
+	t.Helper()
+
+	conns := make(chan *websocket.Conn, 4)
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ws/market" {
+			t.Errorf("path = %s, want /ws/market", r.URL.Path)
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade: %v", err)
+			return
+		}
+		t.Cleanup(func() { conn.Close() })
+		conns <- conn
+	}))
+	t.Cleanup(srv.Close)
+	return "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws", conns
+}
+
+// nextConn waits for the stream to connect
+func nextConn(t *testing.T, conns <-chan *websocket.Conn) *websocket.Conn {
+	t.Helper()
+
+	select {
+	case conn := <-conns:
+		return conn
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a connection")
+		return nil
+	}
+}
+
+// expectSubscription reads the subscription message from conn
+func expectSubscription(t *testing.T, conn *websocket.Conn, want string) {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("reading subscription: %v", err)
+	}
+	var got, wantValue interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("decoding subscription %s: %v", data, err)
+	}
+	json.Unmarshal([]byte(want), &wantValue)
+	if !reflect.DeepEqual(got, wantValue) {
+		t.Errorf("subscription = %s, want %s", data, want)
+	}
+}
+
+// send writes a text message to conn
+func send(t *testing.T, conn *websocket.Conn, message string) {
+	t.Helper()
+
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+}
+
+// nextEvent waits for the next event of the stream
+func nextEvent(t *testing.T, s *MarketStream) MarketEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-s.Events():
+		if !ok {
+			t.Fatalf("stream ended: %v", s.Err())
+		}
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for an event")
+		return nil
+	}
+}
+
+// levels formats price levels as "price@size" pairs
+func levels(levels []PriceLevel) string {
+	parts := make([]string, len(levels))
+	for i, level := range levels {
+		parts[i] = level.Price.String() + "@" + level.Size.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+const (
+	testBookSnapshot = `[{"event_type":"book","asset_id":"111","market":"0xm","hash":"h1","timestamp":"1",
+		"bids":[{"price":"0.48","size":"30"},{"price":"0.5","size":"10"}],
+		"asks":[{"price":"0.53","size":"5"},{"price":"0.52","size":"20"}]}]`
+	testPriceChange = `{"event_type":"price_change","market":"0xm","timestamp":"2","price_changes":[
+		{"asset_id":"111","price":"0.5","size":"0","side":"BUY","hash":"h2"},
+		{"asset_id":"111","price":"0.49","size":"15","side":"BUY","hash":"h2"},
+		{"asset_id":"111","price":"0.52","size":"25","side":"SELL","hash":"h2"}]}`
+)
+
+func TestMarketStreamSnapshotThenDelta(t *testing.T) {
+	wsURL, conns := wsServer(t)
+	c := NewClient(WithWebSocketURL(wsURL))
+
+	stream, err := c.SubscribeMarket([]string{"222", "111"}, nil)
+	if err != nil {
+		t.Fatalf("SubscribeMarket: %v", err)
+	}
+	defer stream.Close()
+
+	conn := nextConn(t, conns)
+	expectSubscription(t, conn, `{"assets_ids":["111","222"],"type":"market"}`)
+
+	if _, ok := stream.Book("111"); ok {
+		t.Error("Book reported a book before the snapshot")
+	}
+
+	send(t, conn, testBookSnapshot)
+	if event, ok := nextEvent(t, stream).(*BookEvent); !ok || event.AssetID != "111" {
+		t.Fatalf("event = %#v, want *BookEvent for 111", event)
+	}
+	book, ok := stream.Book("111")
+	if !ok {
+		t.Fatal("Book reported no book after the snapshot")
+	}
+	if got := levels(book.Bids); got != "0.5@10 0.48@30" {
+		t.Errorf("bids = %s", got)
+	}
+	if got := levels(book.Asks); got != "0.52@20 0.53@5" {
+		t.Errorf("asks = %s", got)
+	}
+
+	send(t, conn, testPriceChange)
+	if event, ok := nextEvent(t, stream).(*PriceChangeEvent); !ok || len(event.Changes) != 3 {
+		t.Fatalf("event = %#v, want *PriceChangeEvent with 3 changes", event)
+	}
+	book, _ = stream.Book("111")
+	if got := levels(book.Bids); got != "0.49@15 0.48@30" {
+		t.Errorf("bids after delta = %s", got)
+	}
+	if got := levels(book.Asks); got != "0.52@25 0.53@5" {
+		t.Errorf("asks after delta = %s", got)
+	}
+	if book.Hash != "h2" || book.Timestamp != "2" || book.Market != "0xm" {
+		t.Errorf("book = hash %q, timestamp %q, market %q", book.Hash, book.Timestamp, book.Market)
+	}
+}
+
+func TestMarketStreamReconnectResubscribes(t *testing.T) {
+	wsURL, conns := wsServer(t)
+	c := NewClient(WithWebSocketURL(wsURL))
+
+	stream, err := c.SubscribeMarket([]string{"111"}, &StreamOptions{ReconnectMinBackoff: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("SubscribeMarket: %v", err)
+	}
+	defer stream.Close()
+
+	conn := nextConn(t, conns)
+	expectSubscription(t, conn, `{"assets_ids":["111"],"type":"market"}`)
+	send(t, conn, testBookSnapshot)
+	nextEvent(t, stream)
+	if _, ok := stream.Book("111"); !ok {
+		t.Fatal("Book reported no book after the snapshot")
+	}
+
+	send(t, conn, `{"event_type":"tick_size_change","asset_id":"111","old_tick_size":"0.01","new_tick_size":"0.001"}`)
+	nextEvent(t, stream)
+
+	// Subscriptions changed while connected are replayed after reconnecting
+	if err := stream.Subscribe("333"); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	conn.Close()
+
+	conn = nextConn(t, conns)
+	expectSubscription(t, conn, `{"assets_ids":["111","333"],"type":"market"}`)
+
+	// A delta before the new snapshot must not resurrect the old book
+	send(t, conn, testPriceChange)
+	nextEvent(t, stream)
+	if book, ok := stream.Book("111"); ok {
+		t.Fatalf("Book after reconnect = %+v, want no book until a new snapshot", book)
+	}
+
+	send(t, conn, `{"event_type":"book","asset_id":"111","market":"0xm","hash":"h3","timestamp":"3",
+		"bids":[{"price":"0.4","size":"1"}],"asks":[]}`)
+	nextEvent(t, stream)
+	book, ok := stream.Book("111")
+	if !ok {
+		t.Fatal("Book reported no book after the new snapshot")
+	}
+	if got := levels(book.Bids) + "|" + levels(book.Asks); got != "0.4@1|" {
+		t.Errorf("book after reconnect = %s", got)
+	}
+	if !book.TickSize.Equal(MustParseDecimal("0.001")) {
+		t.Errorf("tick size after reconnect = %s, want 0.001", book.TickSize)
+	}
+	if stream.Err() != nil {
+		t.Errorf("Err = %v", stream.Err())
+	}
+}
+
+func TestMarketStreamSubscriptionPrecedesUpdates(t *testing.T) {
+	wsURL, conns := wsServer(t)
+	c := NewClient(WithWebSocketURL(wsURL))
+
+	stream, err := c.SubscribeMarket([]string{"111"}, &StreamOptions{ReconnectMinBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("SubscribeMarket: %v", err)
+	}
+	defer stream.Close()
+
+	conn := nextConn(t, conns)
+	expectSubscription(t, conn, `{"assets_ids":["111"],"type":"market"}`)
+
+	// Change the subscription from another goroutine while the stream is
+	// building its reconnection subscription, and give that change time
+	// to reach the new connection first if it can
+	session := stream.session
+	session.writeMu.Lock()
+	subscribe := session.subscribe
+	session.subscribe = func() interface{} {
+		message := subscribe()
+		done := make(chan struct{})
+		go func() {
+			stream.Subscribe("333")
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(50 * time.Millisecond):
+		}
+		return message
+	}
+	session.writeMu.Unlock()
+	conn.Close()
+
+	conn = nextConn(t, conns)
+	expectSubscription(t, conn, `{"assets_ids":["111"],"type":"market"}`)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, data, err := conn.ReadMessage(); err != nil || strings.TrimSpace(string(data)) != `{"assets_ids":["333"],"operation":"subscribe"}` {
+		t.Errorf("second message = %s (%v), want the subscribe operation", data, err)
+	}
+}
+
+func TestMarketStreamPingPong(t *testing.T) {
+	wsURL, conns := wsServer(t)
+	c := NewClient(WithWebSocketURL(wsURL))
+
+	interval := 20 * time.Millisecond
+	stream, err := c.SubscribeMarket([]string{"111"}, &StreamOptions{PingInterval: interval, DisableReconnect: true})
+	if err != nil {
+		t.Fatalf("SubscribeMarket: %v", err)
+	}
+	defer stream.Close()
+
+	conn := nextConn(t, conns)
+	expectSubscription(t, conn, `{"assets_ids":["111"],"type":"market"}`)
+
+	// Answer every PING with a PONG; that alone must keep the connection
+	// open well past the three-interval read timeout
+	var pings int32
+	go func() {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if string(data) != "PING" {
+				t.Errorf("client sent %q, want PING", data)
+				continue
+			}
+			atomic.AddInt32(&pings, 1)
+			if conn.WriteMessage(websocket.TextMessage, []byte("PONG")) != nil {
+				return
+			}
+		}
+	}()
+
+	time.Sleep(10 * interval)
+	if got := atomic.LoadInt32(&pings); got < 3 {
+		t.Errorf("server received %d PINGs in %v, want at least 3", got, 10*interval)
+	}
+	select {
+	case event, ok := <-stream.Events():
+		if !ok {
+			t.Fatalf("stream ended while PONGs were answered: %v", stream.Err())
+		}
+		t.Fatalf("PONG delivered as event %#v", event)
+	default:
+	}
+}
+
+func TestMarketStreamDeadConnection(t *testing.T) {
+	wsURL, conns := wsServer(t)
+	c := NewClient(WithWebSocketURL(wsURL))
+
+	interval := 20 * time.Millisecond
+	stream, err := c.SubscribeMarket([]string{"111"}, &StreamOptions{PingInterval: interval, DisableReconnect: true})
+	if err != nil {
+		t.Fatalf("SubscribeMarket: %v", err)
+	}
+	defer stream.Close()
+	nextConn(t, conns) // never answers
+
+	select {
+	case _, ok := <-stream.Events():
+		if ok {
+			t.Fatal("unexpected event")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not end after the server stopped answering")
+	}
+	if stream.Err() == nil {
+		t.Error("Err = nil, want the read timeout")
+	}
+}
@@ -1,31 +1,24 @@
 package polymarket
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 )
 
 // GetMarkets retrieves a list of markets from the Polymarket API
 func (c *Client) GetMarkets(params *MarketsParams) ([]Market, error) {
-	endpoint := "/markets"
-	queryParams := buildParams(params)
-
-	// Construct the full URL for display
-	fullURL := c.baseURL + endpoint
-	if len(queryParams) > 0 {
-		fullURL += "?" + queryParams.Encode()
-	}
-
-	// Display the URL
-	fmt.Printf("Executing request: %s\n", fullURL)
+	return c.GetMarketsContext(context.Background(), params)
+}
 
-	body, err := c.makeRequest("GET", "/markets", buildParams(params))
+// GetMarketsContext is like GetMarkets but uses ctx for cancellation and deadlines
+func (c *Client) GetMarketsContext(ctx context.Context, params *MarketsParams) ([]Market, error) {
+	body, err := c.makeRequest(ctx, "GET", "/markets", buildParams(params))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch markets: %w", err)
 	}
 
 	var markets []Market
-	if err := json.Unmarshal(body, &markets); err != nil {
+	if err := decodeJSON(body, &markets); err != nil {
 		return nil, fmt.Errorf("failed to parse markets response: %w", err)
 	}
 
@@ -34,20 +27,30 @@ func (c *Client) GetMarkets(params *MarketsParams) ([]Market, error) {
 
 // GetMarket retrieves a specific market by its ID
 func (c *Client) GetMarket(marketID string) (*Market, error) {
-	return c.GetMarketWithParams(marketID, nil)
+	return c.GetMarketWithParamsContext(context.Background(), marketID, nil)
+}
+
+// GetMarketContext is like GetMarket but uses ctx for cancellation and deadlines
+func (c *Client) GetMarketContext(ctx context.Context, marketID string) (*Market, error) {
+	return c.GetMarketWithParamsContext(ctx, marketID, nil)
 }
 
 // GetMarketWithParams retrieves a specific market by its ID with optional parameters
 func (c *Client) GetMarketWithParams(marketID string, params *GetMarketParams) (*Market, error) {
+	return c.GetMarketWithParamsContext(context.Background(), marketID, params)
+}
+
+// GetMarketWithParamsContext is like GetMarketWithParams but uses ctx for cancellation and deadlines
+func (c *Client) GetMarketWithParamsContext(ctx context.Context, marketID string, params *GetMarketParams) (*Market, error) {
 	endpoint := fmt.Sprintf("/markets/%s", marketID)
 
-	body, err := c.makeRequest("GET", endpoint, buildParams(params))
+	body, err := c.makeRequest(ctx, "GET", endpoint, buildParams(params))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch market %s: %w", marketID, err)
 	}
 
 	var market Market
-	if err := json.Unmarshal(body, &market); err != nil {
+	if err := decodeJSON(body, &market); err != nil {
 		return nil, fmt.Errorf("failed to parse market response: %w", err)
 	}
 
@@ -56,18 +59,23 @@ func (c *Client) GetMarketWithParams(marketID string, params *GetMarketParams) (
 
 // GetMarketBySlug retrieves a specific market by its slug
 func (c *Client) GetMarketBySlug(slug string) (*Market, error) {
+	return c.GetMarketBySlugContext(context.Background(), slug)
+}
+
+// GetMarketBySlugContext is like GetMarketBySlug but uses ctx for cancellation and deadlines
+func (c *Client) GetMarketBySlugContext(ctx context.Context, slug string) (*Market, error) {
 	params := &MarketsParams{
 		Slug:  slug,
 		Limit: 1,
 	}
 
-	markets, err := c.GetMarkets(params)
+	markets, err := c.GetMarketsContext(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch market by slug %s: %w", slug, err)
 	}
 
 	if len(markets) == 0 {
-		return nil, fmt.Errorf("market with slug %s not found", slug)
+		return nil, fmt.Errorf("market with slug %s: %w", slug, ErrNotFound)
 	}
 
 	return &markets[0], nil
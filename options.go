@@ -0,0 +1,148 @@
+package polymarket
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option configures a Client created with NewClient
+type Option func(*Client)
+
+// WithBaseURL sets the Gamma API base URL
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if baseURL != "" {
+			c.baseURL = baseURL
+		}
+	}
+}
+
+// WithDataAPIBaseURL sets the Data API base URL, used e.g. for live volume
+func WithDataAPIBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if baseURL != "" {
+			c.dataAPIBaseURL = baseURL
+		}
+	}
+}
+
+// WithCLOBBaseURL sets the CLOB API base URL, used for order books and prices
+func WithCLOBBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if baseURL != "" {
+			c.clobBaseURL = baseURL
+		}
+	}
+}
+
+// WithWebSocketURL sets the CLOB WebSocket base URL; channel paths such as
+// "/market" are appended to it
+func WithWebSocketURL(wsURL string) Option {
+	return func(c *Client) {
+		if wsURL != "" {
+			c.wsBaseURL = strings.TrimRight(wsURL, "/")
+		}
+	}
+}
+
+// WithHTTPClient replaces the underlying HTTP client.
+// Options that tune the HTTP client (WithTimeout, WithTransport) apply to it
+// only when given after this option; they tune a copy, so httpClient itself
+// is never modified.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithTransport sets the RoundTripper used by the underlying HTTP client
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.cloneHTTPClient().Transport = transport
+	}
+}
+
+// WithTimeout sets the HTTP client timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.cloneHTTPClient().Timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		if userAgent != "" {
+			c.userAgent = userAgent
+		}
+	}
+}
+
+// WithHeader adds a header sent with every request
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithLogger sets the logger used for request diagnostics.
+// Method, URL, status, latency, response size and retry attempts are logged at debug level.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed GET requests
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRateLimiter installs a rate limiter for requests sent to baseURL
+func WithRateLimiter(baseURL string, limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.SetRateLimiter(baseURL, limiter)
+	}
+}
+
+// WithProfileCacheSize sets how many public profiles GetProfile caches.
+// Zero disables the cache.
+func WithProfileCacheSize(size int) Option {
+	return func(c *Client) {
+		if size <= 0 {
+			c.profileCache = nil
+			return
+		}
+		ttl := DefaultProfileCacheTTL
+		if c.profileCache != nil {
+			ttl = c.profileCache.ttl
+		}
+		c.profileCache = newLRUCache[string, *PublicProfile](size, ttl)
+	}
+}
+
+// WithProfileCacheTTL sets how long GetProfile keeps a cached profile.
+// Zero keeps profiles until they are evicted.
+func WithProfileCacheTTL(ttl time.Duration) Option {
+	return func(c *Client) {
+		if c.profileCache != nil {
+			c.profileCache.ttl = ttl
+		}
+	}
+}
+
+// WithL2Auth enables L2 API key authentication for the wallet address.
+// Requests to the CLOB host are signed with the credentials, and
+// authenticated methods such as SubscribeUser become available.
+func WithL2Auth(address string, creds APICredentials) Option {
+	return func(c *Client) {
+		c.address = address
+		c.credentials = &creds
+	}
+}
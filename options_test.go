@@ -0,0 +1,34 @@
+package polymarket
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestHTTPClientOptionsDoNotModifySharedClient(t *testing.T) {
+	shared := &http.Client{Timeout: time.Minute}
+	transport := &http.Transport{}
+
+	c := NewClient(
+		WithHTTPClient(shared),
+		WithTimeout(time.Second),
+		WithTransport(transport),
+		WithL2Auth("0xabc", APICredentials{Key: "k", Secret: "c2VjcmV0", Passphrase: "p"}),
+	)
+	c.SetTimeout(2 * time.Second)
+
+	if shared.Timeout != time.Minute || shared.Transport != nil {
+		t.Errorf("shared client modified: timeout %v, transport %v", shared.Timeout, shared.Transport)
+	}
+	if c.httpClient == shared {
+		t.Fatal("client uses the shared *http.Client")
+	}
+	if c.httpClient.Timeout != 2*time.Second {
+		t.Errorf("timeout = %v, want 2s", c.httpClient.Timeout)
+	}
+	l2, ok := c.httpClient.Transport.(*L2Transport)
+	if !ok || l2.Base != transport {
+		t.Errorf("transport = %#v, want L2Transport wrapping the configured transport", c.httpClient.Transport)
+	}
+}
@@ -0,0 +1,317 @@
+package polymarket
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"time"
+)
+
+// PolygonChainID is the chain ID of Polygon mainnet, where the CLOB settles
+const PolygonChainID = 137
+
+// CTF exchange contracts on Polygon mainnet
+const (
+	ExchangeAddress        = "0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E"
+	NegRiskExchangeAddress = "0xC5d563A36AE78145C45a50134d48A1215220f80a"
+)
+
+// SignatureType identifies how the maker of an order signs
+type SignatureType int
+
+const (
+	// SignatureEOA is an order signed by the externally owned account that holds the funds
+	SignatureEOA SignatureType = 0
+
+	// SignaturePolyProxy is an order for a Polymarket proxy wallet, signed by its owner
+	SignaturePolyProxy SignatureType = 1
+
+	// SignaturePolyGnosisSafe is an order for a Polymarket Gnosis Safe, signed by its owner
+	SignaturePolyGnosisSafe SignatureType = 2
+)
+
+// OrderType is the time in force of an order
+type OrderType string
+
+const (
+	OrderTypeGTC OrderType = "GTC" // Good till cancelled
+	OrderTypeGTD OrderType = "GTD" // Good till the order's expiration
+	OrderTypeFOK OrderType = "FOK" // Fill entirely and immediately, or cancel
+	OrderTypeFAK OrderType = "FAK" // Fill what is possible immediately, cancel the rest
+)
+
+// usdcScale converts USDC and outcome token amounts to base units (6 decimals)
+const usdcScale = 1_000_000
+
+// Order is a CLOB order as signed and submitted. Amounts are integers in
+// base units (6 decimals) encoded as decimal strings.
+type Order struct {
+	Salt          int64         `json:"salt"`
+	Maker         string        `json:"maker"`  // Address holding the funds
+	Signer        string        `json:"signer"` // Address that signed the order
+	Taker         string        `json:"taker"`  // ZeroAddress for public orders
+	TokenID       string        `json:"tokenId"`
+	MakerAmount   string        `json:"makerAmount"` // Amount the maker gives
+	TakerAmount   string        `json:"takerAmount"` // Amount the maker receives
+	Expiration    string        `json:"expiration"`  // Unix seconds, "0" for none
+	Nonce         string        `json:"nonce"`
+	FeeRateBps    string        `json:"feeRateBps"`
+	Side          Side          `json:"side"`
+	SignatureType SignatureType `json:"signatureType"`
+	Signature     string        `json:"signature"`
+}
+
+// OrderSubmission is the request body that submits a signed order
+type OrderSubmission struct {
+	Order     *Order    `json:"order"`
+	Owner     string    `json:"owner"` // API key of the submitting user
+	OrderType OrderType `json:"orderType"`
+}
+
+// OrderArgs describes a limit order to build
+type OrderArgs struct {
+	TokenID string
+	Side    Side
+	Price   Decimal // Price per share, between one tick and 1 - tick
+	Size    Decimal // Number of shares
+
+	// TickSize is the market's minimum price increment: 0.1, 0.01, 0.001 or 0.0001
+	TickSize Decimal
+
+	// NegRisk selects the neg-risk exchange, see GetNegRisk
+	NegRisk bool
+
+	FeeRateBps int64
+	Nonce      int64
+	Expiration int64  // Unix seconds; zero for none. Required for GTD orders.
+	Taker      string // Empty for a public order
+}
+
+// roundingConfig is the number of decimals allowed for prices, sizes and
+// amounts at a given tick size
+type roundingConfig struct {
+	price, size, amount int32
+}
+
+var tickRounding = map[string]roundingConfig{
+	"0.1":    {price: 1, size: 2, amount: 3},
+	"0.01":   {price: 2, size: 2, amount: 4},
+	"0.001":  {price: 3, size: 2, amount: 5},
+	"0.0001": {price: 4, size: 2, amount: 6},
+}
+
+// OrderBuilder builds and signs CLOB orders
+type OrderBuilder struct {
+	signer        *Signer
+	signatureType SignatureType
+	funder        string
+	chainID       int64
+
+	// salt generates the random salt of each order
+	salt func() int64
+}
+
+// NewOrderBuilder creates an order builder for Polygon mainnet.
+// funder is the address holding the funds: the signer's own address for
+// SignatureEOA (used when funder is empty), or the proxy wallet or Safe
+// address for SignaturePolyProxy and SignaturePolyGnosisSafe.
+func NewOrderBuilder(signer *Signer, signatureType SignatureType, funder string) *OrderBuilder {
+	if funder == "" {
+		funder = signer.Address()
+	}
+	return &OrderBuilder{
+		signer:        signer,
+		signatureType: signatureType,
+		funder:        funder,
+		chainID:       PolygonChainID,
+		salt: func() int64 {
+			return rand.Int63n(time.Now().Unix())
+		},
+	}
+}
+
+// BuildOrder rounds the order amounts to the tick size and signs the order
+func (b *OrderBuilder) BuildOrder(args OrderArgs) (*Order, error) {
+	order, err := b.newOrder(args)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := b.orderHash(order, args.NegRisk)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := b.signer.SignHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign order: %w", err)
+	}
+	order.Signature = "0x" + hex.EncodeToString(signature)
+
+	return order, nil
+}
+
+// newOrder validates args and fills in an unsigned order
+func (b *OrderBuilder) newOrder(args OrderArgs) (*Order, error) {
+	if args.TokenID == "" {
+		return nil, fmt.Errorf("token ID is required")
+	}
+	if _, ok := new(big.Int).SetString(args.TokenID, 10); !ok {
+		return nil, fmt.Errorf("invalid token ID %q", args.TokenID)
+	}
+
+	config, ok := tickRounding[args.TickSize.String()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported tick size %s", args.TickSize)
+	}
+	if args.Price.LessThan(args.TickSize) || args.Price.GreaterThan(NewDecimalFromInt(1).Sub(args.TickSize)) {
+		return nil, fmt.Errorf("price %s is outside [%s, %s]", args.Price, args.TickSize, NewDecimalFromInt(1).Sub(args.TickSize))
+	}
+	if args.Size.Sign() <= 0 {
+		return nil, fmt.Errorf("size must be positive")
+	}
+	if args.FeeRateBps < 0 || args.Nonce < 0 || args.Expiration < 0 {
+		return nil, fmt.Errorf("fee rate, nonce and expiration must not be negative")
+	}
+
+	makerAmount, takerAmount, err := orderAmounts(args.Side, args.Price, args.Size, config)
+	if err != nil {
+		return nil, err
+	}
+	if makerAmount.Sign() <= 0 || takerAmount.Sign() <= 0 {
+		return nil, fmt.Errorf("order size %s is too small", args.Size)
+	}
+
+	taker := args.Taker
+	if taker == "" {
+		taker = ZeroAddress
+	}
+
+	return &Order{
+		Salt:          b.salt(),
+		Maker:         b.funder,
+		Signer:        b.signer.Address(),
+		Taker:         taker,
+		TokenID:       args.TokenID,
+		MakerAmount:   makerAmount.String(),
+		TakerAmount:   takerAmount.String(),
+		Expiration:    fmt.Sprint(args.Expiration),
+		Nonce:         fmt.Sprint(args.Nonce),
+		FeeRateBps:    fmt.Sprint(args.FeeRateBps),
+		Side:          args.Side,
+		SignatureType: b.signatureType,
+	}, nil
+}
+
+// orderAmounts computes the maker and taker amounts in base units. A buy
+// gives USDC for shares, a sell gives shares for USDC.
+func orderAmounts(side Side, price, size Decimal, config roundingConfig) (maker, taker *big.Int, err error) {
+	price = price.Round(config.price)
+	shares := size.Truncate(config.size)
+	notional := roundAmount(shares.Mul(price), config.amount)
+
+	switch side {
+	case SideBuy:
+		return toBaseUnits(notional), toBaseUnits(shares), nil
+	case SideSell:
+		return toBaseUnits(shares), toBaseUnits(notional), nil
+	}
+	return nil, nil, fmt.Errorf("invalid order side %q", side)
+}
+
+// roundAmount limits a notional amount to the given number of decimals the
+// way the reference clients do: round up at four extra decimals first, then
+// truncate if that was not enough
+func roundAmount(amount Decimal, places int32) Decimal {
+	if amount.places() <= places {
+		return amount
+	}
+	amount = amount.RoundUp(places + 4)
+	if amount.places() > places {
+		amount = amount.Truncate(places)
+	}
+	return amount
+}
+
+// toBaseUnits converts an amount to integer base units with 6 decimals
+func toBaseUnits(amount Decimal) *big.Int {
+	return amount.Mul(NewDecimalFromInt(usdcScale)).Round(0).IntPart()
+}
+
+// EIP-712 type hashes of the exchange domain and order struct
+var (
+	eip712DomainTypeHash = keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	orderTypeHash        = keccak256([]byte("Order(uint256 salt,address maker,address signer,address taker,uint256 tokenId,uint256 makerAmount,uint256 takerAmount,uint256 expiration,uint256 nonce,uint256 feeRateBps,uint8 side,uint8 signatureType)"))
+)
+
+// orderHash returns the EIP-712 digest of an order for the exchange contract
+func (b *OrderBuilder) orderHash(order *Order, negRisk bool) ([]byte, error) {
+	exchange := ExchangeAddress
+	if negRisk {
+		exchange = NegRiskExchangeAddress
+	}
+	verifyingContract, err := parseAddress(exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	domainSeparator := keccak256(
+		eip712DomainTypeHash,
+		keccak256([]byte("Polymarket CTF Exchange")),
+		keccak256([]byte("1")),
+		abiWord(big.NewInt(b.chainID)),
+		abiAddress(verifyingContract),
+	)
+
+	structHash, err := hashOrder(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return keccak256([]byte{0x19, 0x01}, domainSeparator, structHash), nil
+}
+
+// hashOrder returns the EIP-712 struct hash of an order
+func hashOrder(order *Order) ([]byte, error) {
+	if order.Salt < 0 {
+		return nil, fmt.Errorf("invalid order salt %d", order.Salt)
+	}
+	words := [][]byte{orderTypeHash, abiWord(big.NewInt(order.Salt))}
+
+	for _, address := range []string{order.Maker, order.Signer, order.Taker} {
+		raw, err := parseAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, abiAddress(raw))
+	}
+
+	uints := []struct{ name, value string }{
+		{"tokenId", order.TokenID},
+		{"makerAmount", order.MakerAmount},
+		{"takerAmount", order.TakerAmount},
+		{"expiration", order.Expiration},
+		{"nonce", order.Nonce},
+		{"feeRateBps", order.FeeRateBps},
+	}
+	for _, u := range uints {
+		n, ok := new(big.Int).SetString(u.value, 10)
+		if !ok || n.Sign() < 0 || n.BitLen() > 256 {
+			return nil, fmt.Errorf("invalid order %s %q", u.name, u.value)
+		}
+		words = append(words, abiWord(n))
+	}
+
+	var side int64
+	switch order.Side {
+	case SideBuy:
+		side = 0
+	case SideSell:
+		side = 1
+	default:
+		return nil, fmt.Errorf("invalid order side %q", order.Side)
+	}
+	words = append(words, abiWord(big.NewInt(side)), abiWord(big.NewInt(int64(order.SignatureType))))
+
+	return keccak256(words...), nil
+}
@@ -0,0 +1,181 @@
+package polymarket
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+const (
+	// testPrivateKey is the first Hardhat development account
+	testPrivateKey = "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	testAddress    = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+
+	testTokenID      = "71321045679252212594626385532706912750332728571942532289631379312455583992563"
+	testProxyAddress = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+	testSafeAddress  = "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC"
+	testTakerAddress = "0x90F79bf6EB2c4f870365E785982E1f101E93b906"
+)
+
+func TestSignerAddress(t *testing.T) {
+	signer, err := NewSigner(testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	if got := signer.Address(); got != testAddress {
+		t.Errorf("Address = %s, want %s", got, testAddress)
+	}
+}
+
+// TestBuildOrderVectors checks orders against known answers. The expected
+// amounts follow the float rounding of py-clob-client's order builder, and
+// the digests and signatures the EIP-712 encoding of py-order-utils signed
+// with RFC 6979 nonces as eth_account does; they were computed with a
+// standalone Python implementation of those steps, not with this package.
+func TestBuildOrderVectors(t *testing.T) {
+	tests := []struct {
+		name          string
+		signatureType SignatureType
+		funder        string
+		salt          int64
+		args          OrderArgs
+
+		wantMaker, wantTaker string
+		wantDigest           string
+		wantSignature        string
+	}{
+		{
+			name:          "EOA buy tick 0.01",
+			signatureType: SignatureEOA,
+			salt:          479249096354,
+			args:          OrderArgs{TokenID: testTokenID, Side: SideBuy, Price: MustParseDecimal("0.56"), Size: MustParseDecimal("21.04"), TickSize: MustParseDecimal("0.01")},
+			wantMaker:     "11782400",
+			wantTaker:     "21040000",
+			wantDigest:    "b4b76f31806813e91434f259c92a95112a53cac36ba01bcc125deec32774fc0d",
+			wantSignature: "0xf62aa47683c432e0f231c87f3a70503f31ff15921c3bdba625fc2805b4da88a37d064aeb44cbf40df84c2708437ae07f8ad19e8718873a14f33a4501237fe7aa1b",
+		},
+		{
+			name:          "proxy sell tick 0.1",
+			signatureType: SignaturePolyProxy,
+			funder:        testProxyAddress,
+			salt:          123456789,
+			args:          OrderArgs{TokenID: testTokenID, Side: SideSell, Price: MustParseDecimal("0.3"), Size: MustParseDecimal("100.129"), TickSize: MustParseDecimal("0.1")},
+			wantMaker:     "100120000",
+			wantTaker:     "30036000",
+			wantDigest:    "914568c25564cffb1b1fb844c572e9ead844997633423e3fa7f6c5a9932bb324",
+			wantSignature: "0xd643047a4065a3a05a004e7092b110f9e54d22708159fcbc9129919a80168536223ebe5a898d67e7f415960475dcc4b7879af91ff95017fb097a6568041cd1561c",
+		},
+		{
+			name:          "Safe buy tick 0.001 with fee, nonce and expiration",
+			signatureType: SignaturePolyGnosisSafe,
+			funder:        testSafeAddress,
+			salt:          987654321012,
+			args: OrderArgs{TokenID: testTokenID, Side: SideBuy, Price: MustParseDecimal("0.123"), Size: MustParseDecimal("7.5"), TickSize: MustParseDecimal("0.001"),
+				FeeRateBps: 100, Nonce: 3, Expiration: 1735689600},
+			wantMaker:     "922500",
+			wantTaker:     "7500000",
+			wantDigest:    "c4bae94e8ae6dd91a3a830f7b508764119a48d9d0a92fd673ac2ed481f2cd04c",
+			wantSignature: "0x9992aa85374e1ee9596aaaad27b405d130adff63458ea686a802e299e84458150b5d4806eb56184675a629ea8ee1a35ff2ff02babfc4b0907b405bb4214b55cb1b",
+		},
+		{
+			name:          "neg-risk EOA sell tick 0.0001 to a taker",
+			signatureType: SignatureEOA,
+			salt:          1700000000,
+			args: OrderArgs{TokenID: "1234", Side: SideSell, Price: MustParseDecimal("0.0421"), Size: MustParseDecimal("33.337"), TickSize: MustParseDecimal("0.0001"),
+				NegRisk: true, Taker: testTakerAddress},
+			wantMaker:     "33330000",
+			wantTaker:     "1403193",
+			wantDigest:    "e44a8e96b5af88c6055b2047a070923912bec7762c5f76db714c0e440e388b8a",
+			wantSignature: "0x85e8badcbc41d7b33cc0db51214a268b86835ef2fd71098615a3600aa3f1c440411dc185aed9791ab684b43d41aa541849a28140fcfe5d1337575125c3df31171b",
+		},
+		{
+			name:          "neg-risk Safe buy tick 0.01",
+			signatureType: SignaturePolyGnosisSafe,
+			funder:        testSafeAddress,
+			salt:          42,
+			args: OrderArgs{TokenID: testTokenID, Side: SideBuy, Price: MustParseDecimal("0.07"), Size: MustParseDecimal("1234.56"), TickSize: MustParseDecimal("0.01"),
+				NegRisk: true, Nonce: 7},
+			wantMaker:     "86419200",
+			wantTaker:     "1234560000",
+			wantDigest:    "82dc0bf112b7b04d4f4e0b7388cc3051e903a20a5c17100932a56dfa1f9e466a",
+			wantSignature: "0xcc1f6a771813ba3c26cc0ab8b437b71554d380d33d5ef9fb5ba61bcb8e468d31070db29f2a7dd11a5f738e379ab671bebb5a2c6a57f9c24047adc815521cb83b1b",
+		},
+	}
+
+	signer, err := NewSigner(testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewOrderBuilder(signer, tt.signatureType, tt.funder)
+			b.salt = func() int64 { return tt.salt }
+
+			order, err := b.BuildOrder(tt.args)
+			if err != nil {
+				t.Fatalf("BuildOrder: %v", err)
+			}
+
+			if order.MakerAmount != tt.wantMaker || order.TakerAmount != tt.wantTaker {
+				t.Errorf("amounts = %s/%s, want %s/%s", order.MakerAmount, order.TakerAmount, tt.wantMaker, tt.wantTaker)
+			}
+
+			wantFunder := tt.funder
+			if wantFunder == "" {
+				wantFunder = testAddress
+			}
+			wantTaker := tt.args.Taker
+			if wantTaker == "" {
+				wantTaker = ZeroAddress
+			}
+			if order.Maker != wantFunder || order.Signer != testAddress || order.Taker != wantTaker {
+				t.Errorf("maker/signer/taker = %s/%s/%s", order.Maker, order.Signer, order.Taker)
+			}
+			if order.Salt != tt.salt || order.SignatureType != tt.signatureType || order.Side != tt.args.Side {
+				t.Errorf("order = %+v", order)
+			}
+
+			digest, err := b.orderHash(order, tt.args.NegRisk)
+			if err != nil {
+				t.Fatalf("orderHash: %v", err)
+			}
+			if got := hex.EncodeToString(digest); got != tt.wantDigest {
+				t.Errorf("digest = %s, want %s", got, tt.wantDigest)
+			}
+			if order.Signature != tt.wantSignature {
+				t.Errorf("signature = %s, want %s", order.Signature, tt.wantSignature)
+			}
+		})
+	}
+}
+
+func TestBuildOrderInvalidArgs(t *testing.T) {
+	signer, err := NewSigner(testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	b := NewOrderBuilder(signer, SignatureEOA, "")
+
+	valid := OrderArgs{TokenID: testTokenID, Side: SideBuy, Price: MustParseDecimal("0.5"), Size: MustParseDecimal("10"), TickSize: MustParseDecimal("0.01")}
+	tests := []struct {
+		name   string
+		modify func(*OrderArgs)
+	}{
+		{"missing token", func(a *OrderArgs) { a.TokenID = "" }},
+		{"non-numeric token", func(a *OrderArgs) { a.TokenID = "0xabc" }},
+		{"unsupported tick size", func(a *OrderArgs) { a.TickSize = MustParseDecimal("0.05") }},
+		{"price below tick", func(a *OrderArgs) { a.Price = MustParseDecimal("0.001") }},
+		{"price above 1 - tick", func(a *OrderArgs) { a.Price = MustParseDecimal("0.995") }},
+		{"zero size", func(a *OrderArgs) { a.Size = Decimal{} }},
+		{"size below precision", func(a *OrderArgs) { a.Size = MustParseDecimal("0.001") }},
+		{"invalid side", func(a *OrderArgs) { a.Side = "HOLD" }},
+		{"negative nonce", func(a *OrderArgs) { a.Nonce = -1 }},
+	}
+	for _, tt := range tests {
+		args := valid
+		tt.modify(&args)
+		if _, err := b.BuildOrder(args); err == nil {
+			t.Errorf("%s: BuildOrder succeeded", tt.name)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package polymarket
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidOutcomes is returned when a market's outcome fields can't be decoded or don't line up
+var ErrInvalidOutcomes = errors.New("invalid market outcomes")
+
+// Outcome is a single tradable outcome of a market, joined from the
+// market's outcomes, outcome prices and token IDs
+type Outcome struct {
+	Name    string  // e.g. "Yes"
+	Price   Decimal // Last price between 0 and 1
+	TokenID string  // CLOB token ID
+}
+
+// ParsedOutcomes decodes Outcomes, OutcomesPrices and ClobTokenIDs and zips
+// them by index. Token IDs fall back to the Tokens entry with the same
+// outcome name when clobTokenIds is missing. Markets without prices yet
+// get zero prices.
+func (m *Market) ParsedOutcomes() ([]Outcome, error) {
+	names, err := decodeStringList(m.Outcomes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: outcomes: %v", ErrInvalidOutcomes, err)
+	}
+
+	var prices []Decimal
+	if m.OutcomesPrices != "" {
+		if err := json.Unmarshal([]byte(m.OutcomesPrices), &prices); err != nil {
+			return nil, fmt.Errorf("%w: outcome prices: %v", ErrInvalidOutcomes, err)
+		}
+		if len(prices) != len(names) {
+			return nil, fmt.Errorf("%w: %d outcomes but %d prices", ErrInvalidOutcomes, len(names), len(prices))
+		}
+	}
+
+	tokenIDs, err := decodeStringList(m.ClobTokenIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: clob token IDs: %v", ErrInvalidOutcomes, err)
+	}
+	if len(tokenIDs) > 0 && len(tokenIDs) != len(names) {
+		return nil, fmt.Errorf("%w: %d outcomes but %d token IDs", ErrInvalidOutcomes, len(names), len(tokenIDs))
+	}
+
+	outcomes := make([]Outcome, len(names))
+	for i, name := range names {
+		outcomes[i].Name = name
+
+		if prices != nil {
+			outcomes[i].Price = prices[i]
+		}
+
+		if tokenIDs != nil {
+			outcomes[i].TokenID = tokenIDs[i]
+		} else {
+			outcomes[i].TokenID = m.tokenIDForOutcome(name)
+		}
+	}
+
+	return outcomes, nil
+}
+
+// tokenIDForOutcome finds the token for an outcome by name in m.Tokens
+func (m *Market) tokenIDForOutcome(name string) string {
+	for _, token := range m.Tokens {
+		if strings.EqualFold(token.Outcome, name) {
+			return token.TokenID
+		}
+	}
+	return ""
+}
+
+// decodeStringList decodes a JSON-encoded string array such as "[\"Yes\",\"No\"]".
+// An empty input yields a nil slice.
+func decodeStringList(raw string) ([]string, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var list []string
+	if err := json.Unmarshal([]byte(raw), &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,74 @@
+package polymarket
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetPriceHistory retrieves the price history of a token from the CLOB
+func (c *Client) GetPriceHistory(tokenID string, params *PriceHistoryParams) ([]PricePoint, error) {
+	return c.GetPriceHistoryContext(context.Background(), tokenID, params)
+}
+
+// GetPriceHistoryContext is like GetPriceHistory but uses ctx for cancellation and deadlines
+func (c *Client) GetPriceHistoryContext(ctx context.Context, tokenID string, params *PriceHistoryParams) ([]PricePoint, error) {
+	if tokenID == "" {
+		return nil, fmt.Errorf("token ID is required")
+	}
+
+	query := buildParams(params)
+	query.Set("market", tokenID)
+
+	body, err := c.makeRequestWithBaseURL(ctx, c.clobBaseURL, "GET", "/prices-history", query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch price history for token %s: %w", tokenID, err)
+	}
+
+	var resp struct {
+		History []PricePoint `json:"history"`
+	}
+	if err := decodeJSON(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse price history response: %w", err)
+	}
+
+	return resp.History, nil
+}
+
+// GetMarketPriceHistory retrieves the price history of every outcome of a market,
+// in the order of the market's outcomes
+func (c *Client) GetMarketPriceHistory(market *Market, params *PriceHistoryParams) ([]OutcomePriceHistory, error) {
+	return c.GetMarketPriceHistoryContext(context.Background(), market, params)
+}
+
+// GetMarketPriceHistoryContext is like GetMarketPriceHistory but uses ctx for cancellation and deadlines
+func (c *Client) GetMarketPriceHistoryContext(ctx context.Context, market *Market, params *PriceHistoryParams) ([]OutcomePriceHistory, error) {
+	if market == nil {
+		return nil, fmt.Errorf("market is nil")
+	}
+	outcomes, err := market.ParsedOutcomes()
+	if err != nil {
+		return nil, err
+	}
+	if len(outcomes) == 0 {
+		return nil, fmt.Errorf("%w: market has no outcome tokens", ErrInvalidOutcomes)
+	}
+
+	histories := make([]OutcomePriceHistory, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		if outcome.TokenID == "" {
+			return nil, fmt.Errorf("%w: no token ID for outcome %q", ErrInvalidOutcomes, outcome.Name)
+		}
+
+		history, err := c.GetPriceHistoryContext(ctx, outcome.TokenID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		histories = append(histories, OutcomePriceHistory{
+			Outcome: outcome,
+			History: history,
+		})
+	}
+
+	return histories, nil
+}
@@ -0,0 +1,78 @@
+package polymarket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultProfileCacheSize is the number of public profiles cached by default
+	DefaultProfileCacheSize = 256
+
+	// DefaultProfileCacheTTL is how long a public profile is cached by default
+	DefaultProfileCacheTTL = 10 * time.Minute
+)
+
+// GetProfile retrieves the public profile of a wallet address.
+// Profiles are cached by address; see WithProfileCacheSize and
+// WithProfileCacheTTL.
+func (c *Client) GetProfile(address string) (*PublicProfile, error) {
+	return c.GetProfileContext(context.Background(), address)
+}
+
+// GetProfileContext is like GetProfile but uses ctx for cancellation and deadlines
+func (c *Client) GetProfileContext(ctx context.Context, address string) (*PublicProfile, error) {
+	if address == "" {
+		return nil, fmt.Errorf("wallet address is required")
+	}
+
+	key := strings.ToLower(address)
+	if c.profileCache != nil {
+		if profile, ok := c.profileCache.Get(key); ok {
+			return profile.clone(), nil
+		}
+	}
+
+	params := url.Values{}
+	params.Add("address", address)
+
+	body, err := c.makeRequest(ctx, "GET", "/public-profile", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profile for %s: %w", address, err)
+	}
+
+	var profile PublicProfile
+	if err := decodeJSON(body, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse profile response: %w", err)
+	}
+
+	if c.profileCache != nil {
+		c.profileCache.Add(key, profile.clone())
+	}
+
+	return &profile, nil
+}
+
+// ClearProfileCache empties the public profile cache
+func (c *Client) ClearProfileCache() {
+	if c.profileCache != nil {
+		c.profileCache.Purge()
+	}
+}
+
+// clone returns a copy of p that shares no memory with it, so cached
+// profiles cannot be modified through the values handed to callers
+func (p *PublicProfile) clone() *PublicProfile {
+	copied := *p
+	if p.CreatedAt != nil {
+		createdAt := *p.CreatedAt
+		copied.CreatedAt = &createdAt
+	}
+	if p.Users != nil {
+		copied.Users = append([]ProfileUser(nil), p.Users...)
+	}
+	return &copied
+}
@@ -0,0 +1,159 @@
+package polymarket
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// buildParams converts a params struct (or pointer to one) to url.Values
+// using its `url` struct tags, e.g.
+//
+//	Limit  int      `url:"limit,omitempty"`
+//	Active *bool    `url:"active,omitempty"`
+//	ID     []string `url:"id,omitempty"`
+//
+// Fields without a `url` tag, or tagged "-", are skipped. Nil pointers and
+// empty slices are always omitted; with omitempty, zero values are omitted
+// too. Slices repeat the key once per element, or are joined into a single
+// comma-separated value with the "comma" option. Times are encoded as RFC3339,
+// and types implementing encoding.TextMarshaler (such as Decimal) encode as text.
+func buildParams(params interface{}) url.Values {
+	values := url.Values{}
+	if params == nil {
+		return values
+	}
+
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return values
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return values
+	}
+
+	encodeStruct(values, v)
+	return values
+}
+
+// encodeStruct adds the tagged fields of struct v to values
+func encodeStruct(values url.Values, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+
+		// Flatten embedded structs
+		if field.Anonymous && field.Tag.Get("url") == "" {
+			for fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					break
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				encodeStruct(values, fv)
+			}
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("url")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		omitEmpty, comma := false, false
+		for _, opt := range strings.Split(opts, ",") {
+			switch opt {
+			case "omitempty":
+				omitEmpty = true
+			case "comma":
+				comma = true
+			}
+		}
+
+		// Pointers are sent whenever they are set, even to a zero value
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+			omitEmpty = false
+		}
+
+		if fv.Kind() == reflect.Slice && !isTextValue(fv) {
+			var items []string
+			for j := 0; j < fv.Len(); j++ {
+				if s, ok := formatQueryValue(fv.Index(j)); ok {
+					items = append(items, s)
+				}
+			}
+			if comma && len(items) > 0 {
+				values.Add(name, strings.Join(items, ","))
+				continue
+			}
+			for _, item := range items {
+				values.Add(name, item)
+			}
+			continue
+		}
+
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+		if s, ok := formatQueryValue(fv); ok {
+			values.Add(name, s)
+		}
+	}
+}
+
+// isTextValue reports whether v encodes itself as text
+func isTextValue(v reflect.Value) bool {
+	_, ok := v.Interface().(encoding.TextMarshaler)
+	return ok
+}
+
+// formatQueryValue formats a single scalar value for a query string
+func formatQueryValue(v reflect.Value) (string, bool) {
+	switch value := v.Interface().(type) {
+	case time.Time:
+		return value.Format(time.RFC3339), true
+	case encoding.TextMarshaler:
+		text, err := value.MarshalText()
+		if err != nil {
+			return "", false
+		}
+		return string(text), true
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+	case reflect.Pointer:
+		if v.IsNil() {
+			return "", false
+		}
+		return formatQueryValue(v.Elem())
+	}
+
+	return fmt.Sprint(v.Interface()), true
+}
@@ -0,0 +1,127 @@
+package polymarket
+
+import (
+	"testing"
+	"time"
+)
+
+type queryPaging struct {
+	Limit  int `url:"limit,omitempty"`
+	Offset int `url:"offset"`
+}
+
+type queryParams struct {
+	queryPaging
+
+	Active   *bool      `url:"active,omitempty"`
+	MinCount *int       `url:"min_count,omitempty"`
+	Slug     string     `url:"slug,omitempty"`
+	Order    string     `url:"order"`
+	ID       []string   `url:"id,omitempty"`
+	Tags     []int      `url:"tags,omitempty,comma"`
+	Start    *time.Time `url:"start,omitempty"`
+	MinPrice *Decimal   `url:"min_price,omitempty"`
+	MaxPrice Decimal    `url:"max_price,omitempty"`
+	Skipped  string     `url:"-"`
+	Untagged string
+}
+
+func TestBuildParams(t *testing.T) {
+	start := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	price := MustParseDecimal("0.55")
+
+	tests := []struct {
+		name   string
+		params interface{}
+		want   string
+	}{
+		{"nil", nil, ""},
+		{"nil pointer", (*queryParams)(nil), ""},
+		{"not a struct", 42, ""},
+		{"zero values", &queryParams{}, "offset=0&order="},
+		{
+			"pointer zero values are sent",
+			&queryParams{Active: boolPtr(false), MinCount: intPtr(0)},
+			"active=false&min_count=0&offset=0&order=",
+		},
+		{
+			"omitempty",
+			queryParams{queryPaging: queryPaging{Limit: 10, Offset: 20}, Slug: "btc", Order: "volume"},
+			"limit=10&offset=20&order=volume&slug=btc",
+		},
+		{
+			"repeated slice",
+			&queryParams{ID: []string{"1", "2", "3"}},
+			"id=1&id=2&id=3&offset=0&order=",
+		},
+		{
+			"comma slice",
+			&queryParams{Tags: []int{4, 5, 6}},
+			"offset=0&order=&tags=4%2C5%2C6",
+		},
+		{"empty slices", &queryParams{ID: []string{}, Tags: []int{}}, "offset=0&order="},
+		{
+			"time pointer",
+			&queryParams{Start: &start},
+			"offset=0&order=&start=2024-03-01T12%3A30%3A00Z",
+		},
+		{
+			"decimals",
+			&queryParams{MinPrice: &price, MaxPrice: MustParseDecimal("0.9")},
+			"max_price=0.9&min_price=0.55&offset=0&order=",
+		},
+		{
+			"decimal pointer to zero",
+			&queryParams{MinPrice: &Decimal{}},
+			"min_price=0&offset=0&order=",
+		},
+		{
+			"skipped fields",
+			&queryParams{Skipped: "a", Untagged: "b"},
+			"offset=0&order=",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildParams(tt.params).Encode(); got != tt.want {
+				t.Errorf("buildParams = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildParamsAscending(t *testing.T) {
+	tests := []struct {
+		ascending *bool
+		want      string
+	}{
+		{nil, ""},
+		{boolPtr(false), "false"},
+		{boolPtr(true), "true"},
+	}
+	for _, tt := range tests {
+		values := buildParams(&MarketsParams{Ascending: tt.ascending})
+		if got := values.Get("ascending"); got != tt.want {
+			t.Errorf("ascending = %q, want %q", got, tt.want)
+		}
+		if _, ok := values["ascending"]; ok != (tt.ascending != nil) {
+			t.Errorf("ascending sent = %v for %v", ok, tt.ascending)
+		}
+	}
+}
+
+func TestBuildParamsMarketSlugs(t *testing.T) {
+	tests := []struct {
+		params MarketsParams
+		want   string
+	}{
+		{MarketsParams{Slug: "btc"}, "slug=btc"},
+		{MarketsParams{Slugs: []string{"eth", "sol"}}, "slug=eth&slug=sol"},
+		{MarketsParams{Slug: "btc", Slugs: []string{"eth"}}, "slug=btc&slug=eth"},
+	}
+	for _, tt := range tests {
+		if got := buildParams(&tt.params).Encode(); got != tt.want {
+			t.Errorf("buildParams(%+v) = %q, want %q", tt.params, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package polymarket
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token-bucket rate limiter that is safe for concurrent use.
+// A single limiter may be shared by several base URLs to enforce a combined budget.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	stats  RateLimiterStats
+}
+
+// RateLimiterStats reports how much a rate limiter has delayed requests
+type RateLimiterStats struct {
+	Requests   int64         // Requests that went through the limiter
+	Delayed    int64         // Requests that had to wait for a token
+	TotalDelay time.Duration // Total time spent waiting
+	MaxDelay   time.Duration // Longest single wait
+}
+
+// NewRateLimiter creates a limiter allowing ratePerSecond requests on average
+// with bursts of up to burst requests
+func NewRateLimiter(ratePerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   ratePerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may proceed or ctx is done.
+// It returns how long the caller was delayed.
+func (l *RateLimiter) Wait(ctx context.Context) (time.Duration, error) {
+	if l.rate <= 0 {
+		return 0, nil
+	}
+
+	// Reserve a token, possibly going into debt, and work out how long to wait for it
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if err := sleepContext(ctx, delay); err != nil {
+		// Give the reserved token back so cancelled calls don't starve others
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return 0, err
+	}
+
+	l.mu.Lock()
+	l.stats.Requests++
+	if delay > 0 {
+		l.stats.Delayed++
+		l.stats.TotalDelay += delay
+		if delay > l.stats.MaxDelay {
+			l.stats.MaxDelay = delay
+		}
+	}
+	l.mu.Unlock()
+
+	return delay, nil
+}
+
+// Stats returns a snapshot of the limiter's delay metrics
+func (l *RateLimiter) Stats() RateLimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// SetRateLimiter installs a rate limiter for requests sent to baseURL
+// (e.g. DefaultBaseURL or DataAPIBaseURL). A nil limiter removes it.
+func (c *Client) SetRateLimiter(baseURL string, limiter *RateLimiter) {
+	c.limitersMu.Lock()
+	defer c.limitersMu.Unlock()
+
+	if c.rateLimiters == nil {
+		c.rateLimiters = make(map[string]*RateLimiter)
+	}
+
+	key := normalizeBaseURL(baseURL)
+	if limiter == nil {
+		delete(c.rateLimiters, key)
+		return
+	}
+	c.rateLimiters[key] = limiter
+}
+
+// RateLimiter returns the rate limiter configured for baseURL, or nil if there is none
+func (c *Client) RateLimiter(baseURL string) *RateLimiter {
+	c.limitersMu.RLock()
+	defer c.limitersMu.RUnlock()
+	return c.rateLimiters[normalizeBaseURL(baseURL)]
+}
+
+// waitRateLimit blocks on the rate limiter for baseURL, if any
+func (c *Client) waitRateLimit(ctx context.Context, baseURL string) error {
+	limiter := c.RateLimiter(baseURL)
+	if limiter == nil {
+		return nil
+	}
+
+	_, err := limiter.Wait(ctx)
+	return err
+}
+
+// normalizeBaseURL makes base URLs comparable regardless of a trailing slash
+func normalizeBaseURL(baseURL string) string {
+	return strings.TrimRight(baseURL, "/")
+}
@@ -0,0 +1,202 @@
+package polymarket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fastRetryPolicy retries quickly so tests do not sleep
+func fastRetryPolicy(maxAttempts int) *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = maxAttempts
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	policy.Jitter = 0
+	return policy
+}
+
+// statusServer answers with the given statuses in turn, then 200 with "[]"
+func statusServer(t *testing.T, statuses ...int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&calls, 1))
+		if n <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		w.Write([]byte("[]"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestRetryThenSuccess(t *testing.T) {
+	srv, calls := statusServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	c := NewClient(WithBaseURL(srv.URL), WithRetryPolicy(fastRetryPolicy(3)))
+
+	body, err := c.makeRequest(context.Background(), "GET", "/markets", nil)
+	if err != nil {
+		t.Fatalf("makeRequest: %v", err)
+	}
+	if string(body) != "[]" {
+		t.Errorf("body = %q, want []", body)
+	}
+	if got := atomic.LoadInt32(calls); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
+
+func TestNoRetryForNonIdempotentMethods(t *testing.T) {
+	for _, method := range []string{"POST", "DELETE"} {
+		t.Run(method, func(t *testing.T) {
+			srv, calls := statusServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+			c := NewClient(WithBaseURL(srv.URL), WithRetryPolicy(fastRetryPolicy(3)))
+
+			_, err := c.makeRequestWithBody(context.Background(), srv.URL, method, "/order", nil, map[string]string{"a": "b"})
+			if !errors.Is(err, ErrServer) {
+				t.Fatalf("error = %v, want ErrServer", err)
+			}
+			if got := atomic.LoadInt32(calls); got != 1 {
+				t.Errorf("attempts = %d, want 1", got)
+			}
+		})
+	}
+}
+
+func TestRetryAttemptsMatchMaxAttempts(t *testing.T) {
+	for _, maxAttempts := range []int{1, 2, 4} {
+		srv, calls := statusServer(t, 500, 500, 500, 500, 500)
+		c := NewClient(WithBaseURL(srv.URL), WithRetryPolicy(fastRetryPolicy(maxAttempts)))
+
+		_, err := c.makeRequest(context.Background(), "GET", "/markets", nil)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != 500 {
+			t.Fatalf("MaxAttempts %d: error = %v, want *APIError with code 500", maxAttempts, err)
+		}
+		if got := atomic.LoadInt32(calls); int(got) != maxAttempts {
+			t.Errorf("MaxAttempts %d: attempts = %d", maxAttempts, got)
+		}
+	}
+}
+
+func TestRetryAfterHonoured(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer srv.Close()
+
+	policy := fastRetryPolicy(2)
+	policy.MaxBackoff = 5 * time.Second
+	c := NewClient(WithBaseURL(srv.URL), WithRetryPolicy(policy))
+
+	start := time.Now()
+	if _, err := c.makeRequest(context.Background(), "GET", "/markets", nil); err != nil {
+		t.Fatalf("makeRequest: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least the 1s Retry-After", elapsed)
+	}
+}
+
+func TestRetryAfterCappedAtMaxBackoff(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "86400")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer srv.Close()
+
+	c := NewClient(WithBaseURL(srv.URL), WithRetryPolicy(fastRetryPolicy(2)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := c.makeRequest(ctx, "GET", "/markets", nil); err != nil {
+		t.Fatalf("makeRequest: %v", err)
+	}
+}
+
+func TestRetryContextCancelledDuringBackoff(t *testing.T) {
+	srv, calls := statusServer(t, 500, 500, 500)
+
+	policy := fastRetryPolicy(3)
+	policy.InitialBackoff = 10 * time.Second
+	policy.MaxBackoff = 10 * time.Second
+	c := NewClient(WithBaseURL(srv.URL), WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := c.makeRequest(ctx, "GET", "/markets", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("cancellation took %v", elapsed)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+func TestNoRetryForRequestConstructionErrors(t *testing.T) {
+	var retryable int32
+	policy := DefaultRetryPolicy()
+	policy.Retryable = func(status int, err error) bool {
+		atomic.AddInt32(&retryable, 1)
+		return true
+	}
+
+	for _, baseURL := range []string{"http://bad host", "localhost:8080", "/relative"} {
+		c := NewClient(WithBaseURL(baseURL), WithRetryPolicy(policy))
+		start := time.Now()
+		if _, err := c.makeRequest(context.Background(), "GET", "/markets", nil); err == nil {
+			t.Errorf("%s: makeRequest succeeded", baseURL)
+		}
+		if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+			t.Errorf("%s: makeRequest took %v, want an immediate error", baseURL, elapsed)
+		}
+	}
+	if got := atomic.LoadInt32(&retryable); got != 0 {
+		t.Errorf("Retryable called %d times, want 0", got)
+	}
+}
+
+func TestRetryTransportErrorsOnly(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	transportErr := &url.Error{Op: "Get", URL: "http://example.com", Err: errors.New("connection refused")}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"transport error", transportErr, true},
+		{"cancelled", &url.Error{Op: "Get", URL: "http://example.com", Err: context.Canceled}, false},
+		{"deadline", &url.Error{Op: "Get", URL: "http://example.com", Err: context.DeadlineExceeded}, false},
+		{"other error", errors.New("failed to create request"), false},
+	}
+	for _, tt := range tests {
+		if got := policy.shouldRetry(0, tt.err); got != tt.want {
+			t.Errorf("%s: shouldRetry = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
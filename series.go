@@ -0,0 +1,99 @@
+package polymarket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// GetSeries retrieves a list of series from the Polymarket API
+func (c *Client) GetSeries(params *SeriesParams) ([]Series, error) {
+	return c.GetSeriesContext(context.Background(), params)
+}
+
+// GetSeriesContext is like GetSeries but uses ctx for cancellation and deadlines
+func (c *Client) GetSeriesContext(ctx context.Context, params *SeriesParams) ([]Series, error) {
+	body, err := c.makeRequest(ctx, "GET", "/series", buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch series: %w", err)
+	}
+
+	var series []Series
+	if err := decodeJSON(body, &series); err != nil {
+		return nil, fmt.Errorf("failed to parse series response: %w", err)
+	}
+
+	return series, nil
+}
+
+// GetSeriesByID retrieves a specific series by its ID
+func (c *Client) GetSeriesByID(seriesID string) (*Series, error) {
+	return c.GetSeriesByIDContext(context.Background(), seriesID)
+}
+
+// GetSeriesByIDContext is like GetSeriesByID but uses ctx for cancellation and deadlines
+func (c *Client) GetSeriesByIDContext(ctx context.Context, seriesID string) (*Series, error) {
+	endpoint := fmt.Sprintf("/series/%s", url.PathEscape(seriesID))
+
+	body, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch series %s: %w", seriesID, err)
+	}
+
+	var series Series
+	if err := decodeJSON(body, &series); err != nil {
+		return nil, fmt.Errorf("failed to parse series response: %w", err)
+	}
+
+	return &series, nil
+}
+
+// GetSeriesBySlug retrieves a specific series by its slug
+func (c *Client) GetSeriesBySlug(slug string) (*Series, error) {
+	return c.GetSeriesBySlugContext(context.Background(), slug)
+}
+
+// GetSeriesBySlugContext is like GetSeriesBySlug but uses ctx for cancellation and deadlines
+func (c *Client) GetSeriesBySlugContext(ctx context.Context, slug string) (*Series, error) {
+	params := &SeriesParams{
+		Slug:  []string{slug},
+		Limit: 1,
+	}
+
+	series, err := c.GetSeriesContext(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch series by slug %s: %w", slug, err)
+	}
+
+	if len(series) == 0 {
+		return nil, fmt.Errorf("series with slug %s: %w", slug, ErrNotFound)
+	}
+
+	return &series[0], nil
+}
+
+// GetSeriesEvents retrieves all events in a series ordered by start date.
+// Events without a start date come last.
+func (c *Client) GetSeriesEvents(seriesID string) ([]Event, error) {
+	return c.GetSeriesEventsContext(context.Background(), seriesID)
+}
+
+// GetSeriesEventsContext is like GetSeriesEvents but uses ctx for cancellation and deadlines
+func (c *Client) GetSeriesEventsContext(ctx context.Context, seriesID string) ([]Event, error) {
+	series, err := c.GetSeriesByIDContext(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := append([]Event(nil), series.Events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i].StartDate, events[j].StartDate
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+
+	return events, nil
+}
@@ -0,0 +1,75 @@
+package polymarket
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestGetSeries(t *testing.T) {
+	srv := routeServer(t, map[string]string{
+		"/series?closed=false&limit=2&recurrence=daily&slug=btc-daily&slug=eth-daily": `[{"id":"10","slug":"btc-daily","recurrence":"daily"},{"id":"11","slug":"eth-daily"}]`,
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	series, err := c.GetSeriesContext(context.Background(), &SeriesParams{
+		Limit:      2,
+		Slug:       []string{"btc-daily", "eth-daily"},
+		Closed:     boolPtr(false),
+		Recurrence: "daily",
+	})
+	if err != nil {
+		t.Fatalf("GetSeries: %v", err)
+	}
+	if len(series) != 2 || series[0].ID != "10" || series[0].Recurrence != "daily" || series[1].Slug != "eth-daily" {
+		t.Errorf("series = %+v", series)
+	}
+}
+
+func TestGetSeriesBySlug(t *testing.T) {
+	srv := routeServer(t, map[string]string{
+		"/series?limit=1&slug=btc-daily": `[{"id":"10","slug":"btc-daily"}]`,
+		"/series?limit=1&slug=missing":   `[]`,
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	series, err := c.GetSeriesBySlugContext(context.Background(), "btc-daily")
+	if err != nil {
+		t.Fatalf("GetSeriesBySlug: %v", err)
+	}
+	if series.ID != "10" {
+		t.Errorf("series = %+v, want ID 10", series)
+	}
+
+	if series, err := c.GetSeriesBySlugContext(context.Background(), "missing"); !errors.Is(err, ErrNotFound) || series != nil {
+		t.Errorf("missing slug = %+v, %v; want nil, ErrNotFound", series, err)
+	}
+}
+
+func TestGetSeriesEventsOrdersByStartDate(t *testing.T) {
+	srv := routeServer(t, map[string]string{
+		"/series/a%2Fb": `{"id":"a/b","events":[
+			{"id":"3","startDate":"2024-03-01T00:00:00Z"},
+			{"id":"none"},
+			{"id":"1","startDate":"2024-01-01T00:00:00Z"},
+			{"id":"2","startDate":"2024-02-01T00:00:00Z"}
+		]}`,
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	events, err := c.GetSeriesEventsContext(context.Background(), "a/b")
+	if err != nil {
+		t.Fatalf("GetSeriesEvents: %v", err)
+	}
+	var ids []string
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	if len(ids) != 4 || ids[0] != "1" || ids[1] != "2" || ids[2] != "3" || ids[3] != "none" {
+		t.Errorf("events = %v, want 1, 2, 3, none", ids)
+	}
+
+	if _, err := c.GetSeriesByIDContext(context.Background(), "404"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("missing series: error = %v, want ErrNotFound", err)
+	}
+}
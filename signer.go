@@ -0,0 +1,117 @@
+package polymarket
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+// ZeroAddress is the zero Ethereum address, used as the taker of public orders
+const ZeroAddress = "0x0000000000000000000000000000000000000000"
+
+// Signer holds a private key used to sign orders
+type Signer struct {
+	key     *secp256k1.PrivateKey
+	address string
+}
+
+// NewSigner creates a signer from a hex-encoded private key, with or without the 0x prefix
+func NewSigner(privateKeyHex string) (*Signer, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(privateKeyHex), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("invalid private key: expected 32 bytes, got %d", len(raw))
+	}
+
+	key := secp256k1.PrivKeyFromBytes(raw)
+	if key.Key.IsZero() {
+		return nil, fmt.Errorf("invalid private key: zero key")
+	}
+
+	// The address is the last 20 bytes of the Keccak-256 hash of the
+	// uncompressed public key without its 0x04 prefix
+	pub := key.PubKey().SerializeUncompressed()
+	address := checksumAddress(keccak256(pub[1:])[12:])
+
+	return &Signer{key: key, address: address}, nil
+}
+
+// Address returns the EIP-55 checksummed address of the signer
+func (s *Signer) Address() string {
+	return s.address
+}
+
+// SignHash signs a 32-byte hash and returns the 65-byte r || s || v
+// signature, with v being 27 or 28
+func (s *Signer) SignHash(hash []byte) ([]byte, error) {
+	if len(hash) != 32 {
+		return nil, fmt.Errorf("hash must be 32 bytes, got %d", len(hash))
+	}
+
+	// SignCompact returns v || r || s with v = 27 + recovery ID
+	compact := ecdsa.SignCompact(s.key, hash, false)
+
+	signature := make([]byte, 65)
+	copy(signature, compact[1:])
+	signature[64] = compact[0]
+	return signature, nil
+}
+
+// keccak256 returns the Keccak-256 hash of the concatenated data
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// checksumAddress formats a 20-byte address with the EIP-55 mixed-case checksum
+func checksumAddress(address []byte) string {
+	lower := hex.EncodeToString(address)
+	hash := keccak256([]byte(lower))
+
+	out := []byte(lower)
+	for i, c := range out {
+		if c < 'a' {
+			continue
+		}
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if nibble&0x0f >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(out)
+}
+
+// parseAddress decodes a hex address into its 20 bytes
+func parseAddress(address string) ([]byte, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil || len(raw) != 20 {
+		return nil, fmt.Errorf("invalid address %q", address)
+	}
+	return raw, nil
+}
+
+// abiWord encodes a non-negative integer as a 32-byte big-endian ABI word
+func abiWord(n *big.Int) []byte {
+	word := make([]byte, 32)
+	return n.FillBytes(word)
+}
+
+// abiAddress encodes a 20-byte address as a left-padded ABI word
+func abiAddress(address []byte) []byte {
+	word := make([]byte, 32)
+	copy(word[12:], address)
+	return word
+}
@@ -0,0 +1,132 @@
+package polymarket
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// fillPlaces is the precision of computed fill prices, shares and impact
+const fillPlaces = 8
+
+// SizeUnit tells how the amount of a simulated market order is expressed
+type SizeUnit int
+
+const (
+	// SizeShares is a number of outcome shares
+	SizeShares SizeUnit = iota
+
+	// SizeNotional is an amount of USDC to spend (buy) or receive (sell)
+	SizeNotional
+)
+
+// FillEstimate is the simulated execution of a market order against an order book
+type FillEstimate struct {
+	Side           Side
+	Filled         bool    // Whether the book can fill the whole amount
+	Shares         Decimal // Shares bought or sold
+	Notional       Decimal // USDC spent or received
+	AveragePrice   Decimal // Notional / Shares
+	WorstPrice     Decimal // Price of the last level consumed
+	LevelsConsumed int     // Number of price levels touched
+
+	// Midpoint is the book midpoint before the order; HasMidpoint is false
+	// if either side of the book is empty, in which case PriceImpact is zero
+	Midpoint    Decimal
+	HasMidpoint bool
+
+	// PriceImpact is how much worse the average price is than the midpoint,
+	// as a fraction of the midpoint (0.01 is 1%)
+	PriceImpact Decimal
+}
+
+// SimulateMarketOrder walks the book to estimate the fill of a market order
+// of the given amount: a buy consumes asks from the lowest price up, a sell
+// consumes bids from the highest price down. Levels may be in any order.
+func (b *OrderBook) SimulateMarketOrder(side Side, amount Decimal, unit SizeUnit) (*FillEstimate, error) {
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	if unit != SizeShares && unit != SizeNotional {
+		return nil, fmt.Errorf("invalid size unit %d", unit)
+	}
+
+	var levels []PriceLevel
+	switch side {
+	case SideBuy:
+		levels = append(levels, b.Asks...)
+		sort.SliceStable(levels, func(i, j int) bool {
+			return levels[i].Price.LessThan(levels[j].Price)
+		})
+	case SideSell:
+		levels = append(levels, b.Bids...)
+		sort.SliceStable(levels, func(i, j int) bool {
+			return levels[i].Price.GreaterThan(levels[j].Price)
+		})
+	default:
+		return nil, fmt.Errorf("invalid order side %q", side)
+	}
+
+	estimate := &FillEstimate{Side: side}
+	remaining := amount
+	for _, level := range levels {
+		if remaining.Sign() <= 0 {
+			break
+		}
+		if level.Size.Sign() <= 0 || level.Price.Sign() <= 0 {
+			continue
+		}
+
+		shares, notional := level.Size, level.Size.Mul(level.Price)
+		switch unit {
+		case SizeShares:
+			if remaining.LessThan(shares) {
+				shares, notional = remaining, remaining.Mul(level.Price)
+			}
+			remaining = remaining.Sub(shares)
+		case SizeNotional:
+			if remaining.LessThan(notional) {
+				shares, notional = remaining.Div(level.Price, fillPlaces), remaining
+			}
+			remaining = remaining.Sub(notional)
+		}
+
+		estimate.Shares = estimate.Shares.Add(shares)
+		estimate.Notional = estimate.Notional.Add(notional)
+		estimate.WorstPrice = level.Price
+		estimate.LevelsConsumed++
+	}
+
+	estimate.Filled = remaining.Sign() <= 0
+	if estimate.Shares.Sign() > 0 {
+		estimate.AveragePrice = estimate.Notional.Div(estimate.Shares, fillPlaces)
+	}
+
+	if mid, ok := b.Midpoint(); ok && mid.Sign() > 0 {
+		estimate.Midpoint, estimate.HasMidpoint = mid, true
+		if estimate.Shares.Sign() > 0 {
+			diff := estimate.AveragePrice.Sub(mid)
+			if side == SideSell {
+				diff = diff.Neg()
+			}
+			estimate.PriceImpact = diff.Div(mid, fillPlaces)
+		}
+	}
+
+	return estimate, nil
+}
+
+// SimulateMarketOrder fetches the live order book of a token and estimates
+// the fill of a market order against it; see OrderBook.SimulateMarketOrder
+func (c *Client) SimulateMarketOrder(tokenID string, side Side, amount Decimal, unit SizeUnit) (*FillEstimate, error) {
+	return c.SimulateMarketOrderContext(context.Background(), tokenID, side, amount, unit)
+}
+
+// SimulateMarketOrderContext is like SimulateMarketOrder but uses ctx for cancellation and deadlines
+func (c *Client) SimulateMarketOrderContext(ctx context.Context, tokenID string, side Side, amount Decimal, unit SizeUnit) (*FillEstimate, error) {
+	book, err := c.GetOrderBookContext(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	return book.SimulateMarketOrder(side, amount, unit)
+}
@@ -0,0 +1,135 @@
+package polymarket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testLevels builds price levels from "price", "size" pairs
+func testLevels(pairs ...string) []PriceLevel {
+	levels := make([]PriceLevel, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		levels = append(levels, PriceLevel{Price: MustParseDecimal(pairs[i]), Size: MustParseDecimal(pairs[i+1])})
+	}
+	return levels
+}
+
+func TestSimulateMarketOrder(t *testing.T) {
+	// Levels are deliberately out of order; the midpoint is 0.5
+	book := &OrderBook{
+		Bids: testLevels("0.45", "100", "0.48", "60"),
+		Asks: testLevels("0.55", "50", "0.6", "100", "0.52", "50"),
+	}
+	bidsOnly := &OrderBook{Bids: book.Bids}
+	asksOnly := &OrderBook{Asks: book.Asks}
+
+	tests := []struct {
+		name   string
+		book   *OrderBook
+		side   Side
+		amount string
+		unit   SizeUnit
+
+		filled                           bool
+		shares, notional, average, worst string
+		levels                           int
+		hasMidpoint                      bool
+		impact                           string
+	}{
+		{"buy shares across levels", book, SideBuy, "100", SizeShares,
+			true, "100", "53.5", "0.535", "0.55", 2, true, "0.07"},
+		{"buy shares within one level", book, SideBuy, "10", SizeShares,
+			true, "10", "5.2", "0.52", "0.52", 1, true, "0.04"},
+		{"buy notional across levels", book, SideBuy, "40", SizeNotional,
+			true, "75.45454545", "40", "0.53012048", "0.55", 2, true, "0.06024096"},
+		{"sell shares across levels", book, SideSell, "100", SizeShares,
+			true, "100", "46.8", "0.468", "0.45", 2, true, "0.064"},
+		{"sell notional within one level", book, SideSell, "10", SizeNotional,
+			true, "20.83333333", "10", "0.48", "0.48", 1, true, "0.04"},
+		{"buy more shares than the book holds", book, SideBuy, "250", SizeShares,
+			false, "200", "113.5", "0.5675", "0.6", 3, true, "0.135"},
+		{"sell more notional than the book holds", book, SideSell, "100", SizeNotional,
+			false, "160", "73.8", "0.46125", "0.45", 2, true, "0.0775"},
+		{"buy against an empty ask side", bidsOnly, SideBuy, "10", SizeShares,
+			false, "0", "0", "0", "0", 0, false, "0"},
+		{"sell into a bids-only book", bidsOnly, SideSell, "60", SizeShares,
+			true, "60", "28.8", "0.48", "0.48", 1, false, "0"},
+		{"buy from an asks-only book", asksOnly, SideBuy, "50", SizeNotional,
+			true, "93.63636364", "50", "0.53398058", "0.55", 2, false, "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.book.SimulateMarketOrder(tt.side, MustParseDecimal(tt.amount), tt.unit)
+			if err != nil {
+				t.Fatalf("SimulateMarketOrder: %v", err)
+			}
+
+			if got.Side != tt.side || got.Filled != tt.filled || got.LevelsConsumed != tt.levels || got.HasMidpoint != tt.hasMidpoint {
+				t.Errorf("side %s, filled %v, levels %d, hasMidpoint %v; want %s, %v, %d, %v",
+					got.Side, got.Filled, got.LevelsConsumed, got.HasMidpoint, tt.side, tt.filled, tt.levels, tt.hasMidpoint)
+			}
+			decimals := []struct {
+				name      string
+				got, want Decimal
+			}{
+				{"Shares", got.Shares, MustParseDecimal(tt.shares)},
+				{"Notional", got.Notional, MustParseDecimal(tt.notional)},
+				{"AveragePrice", got.AveragePrice, MustParseDecimal(tt.average)},
+				{"WorstPrice", got.WorstPrice, MustParseDecimal(tt.worst)},
+				{"PriceImpact", got.PriceImpact, MustParseDecimal(tt.impact)},
+			}
+			for _, d := range decimals {
+				if !d.got.Equal(d.want) {
+					t.Errorf("%s = %s, want %s", d.name, d.got, d.want)
+				}
+			}
+			if tt.hasMidpoint && !got.Midpoint.Equal(MustParseDecimal("0.5")) {
+				t.Errorf("Midpoint = %s, want 0.5", got.Midpoint)
+			}
+		})
+	}
+}
+
+func TestSimulateMarketOrderInvalid(t *testing.T) {
+	book := &OrderBook{Asks: testLevels("0.5", "10")}
+
+	tests := []struct {
+		name   string
+		side   Side
+		amount Decimal
+		unit   SizeUnit
+	}{
+		{"zero amount", SideBuy, Decimal{}, SizeShares},
+		{"negative amount", SideBuy, MustParseDecimal("-5"), SizeNotional},
+		{"invalid side", Side("HOLD"), MustParseDecimal("5"), SizeShares},
+		{"invalid unit", SideBuy, MustParseDecimal("5"), SizeUnit(7)},
+	}
+	for _, tt := range tests {
+		if got, err := book.SimulateMarketOrder(tt.side, tt.amount, tt.unit); err == nil {
+			t.Errorf("%s: SimulateMarketOrder = %+v, want error", tt.name, got)
+		}
+	}
+}
+
+func TestClientSimulateMarketOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/book" || r.URL.Query().Get("token_id") != "1234" {
+			t.Errorf("request = %s, want /book?token_id=1234", r.URL)
+		}
+		w.Write([]byte(`{"market":"0xm","asset_id":"1234",
+			"bids":[{"price":"0.48","size":"60"}],
+			"asks":[{"price":"0.55","size":"50"},{"price":"0.52","size":"50"}]}`))
+	}))
+	defer srv.Close()
+	c := NewClient(WithCLOBBaseURL(srv.URL))
+
+	got, err := c.SimulateMarketOrderContext(context.Background(), "1234", SideBuy, MustParseDecimal("100"), SizeShares)
+	if err != nil {
+		t.Fatalf("SimulateMarketOrder: %v", err)
+	}
+	if !got.Filled || !got.AveragePrice.Equal(MustParseDecimal("0.535")) || !got.PriceImpact.Equal(MustParseDecimal("0.07")) {
+		t.Errorf("estimate = filled %v, average %s, impact %s; want true, 0.535, 0.07", got.Filled, got.AveragePrice, got.PriceImpact)
+	}
+}
@@ -0,0 +1,145 @@
+package polymarket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// GetTags retrieves a list of tags from the Polymarket API
+func (c *Client) GetTags(params *TagsParams) ([]Tag, error) {
+	return c.GetTagsContext(context.Background(), params)
+}
+
+// GetTagsContext is like GetTags but uses ctx for cancellation and deadlines
+func (c *Client) GetTagsContext(ctx context.Context, params *TagsParams) ([]Tag, error) {
+	body, err := c.makeRequest(ctx, "GET", "/tags", buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	var tags []Tag
+	if err := decodeJSON(body, &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse tags response: %w", err)
+	}
+
+	return tags, nil
+}
+
+// GetTag retrieves a specific tag by its ID
+func (c *Client) GetTag(tagID string) (*Tag, error) {
+	return c.GetTagContext(context.Background(), tagID)
+}
+
+// GetTagContext is like GetTag but uses ctx for cancellation and deadlines
+func (c *Client) GetTagContext(ctx context.Context, tagID string) (*Tag, error) {
+	endpoint := fmt.Sprintf("/tags/%s", url.PathEscape(tagID))
+
+	body, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tag %s: %w", tagID, err)
+	}
+
+	var tag Tag
+	if err := decodeJSON(body, &tag); err != nil {
+		return nil, fmt.Errorf("failed to parse tag response: %w", err)
+	}
+
+	return &tag, nil
+}
+
+// GetTagBySlug retrieves a specific tag by its slug
+func (c *Client) GetTagBySlug(slug string) (*Tag, error) {
+	return c.GetTagBySlugContext(context.Background(), slug)
+}
+
+// GetTagBySlugContext is like GetTagBySlug but uses ctx for cancellation and deadlines
+func (c *Client) GetTagBySlugContext(ctx context.Context, slug string) (*Tag, error) {
+	endpoint := fmt.Sprintf("/tags/slug/%s", url.PathEscape(slug))
+
+	body, err := c.makeRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tag by slug %s: %w", slug, err)
+	}
+
+	var tag Tag
+	if err := decodeJSON(body, &tag); err != nil {
+		return nil, fmt.Errorf("failed to parse tag response: %w", err)
+	}
+
+	return &tag, nil
+}
+
+// GetRelatedTagRelationships retrieves the relationships between a tag and its related tags
+func (c *Client) GetRelatedTagRelationships(tagID string, params *RelatedTagsParams) ([]RelatedTag, error) {
+	return c.GetRelatedTagRelationshipsContext(context.Background(), tagID, params)
+}
+
+// GetRelatedTagRelationshipsContext is like GetRelatedTagRelationships but uses ctx for cancellation and deadlines
+func (c *Client) GetRelatedTagRelationshipsContext(ctx context.Context, tagID string, params *RelatedTagsParams) ([]RelatedTag, error) {
+	endpoint := fmt.Sprintf("/tags/%s/related-tags", url.PathEscape(tagID))
+	return c.getRelatedTagRelationships(ctx, endpoint, tagID, params)
+}
+
+// GetRelatedTagRelationshipsBySlug retrieves the relationships between a tag, given by slug, and its related tags
+func (c *Client) GetRelatedTagRelationshipsBySlug(slug string, params *RelatedTagsParams) ([]RelatedTag, error) {
+	return c.GetRelatedTagRelationshipsBySlugContext(context.Background(), slug, params)
+}
+
+// GetRelatedTagRelationshipsBySlugContext is like GetRelatedTagRelationshipsBySlug but uses ctx for cancellation and deadlines
+func (c *Client) GetRelatedTagRelationshipsBySlugContext(ctx context.Context, slug string, params *RelatedTagsParams) ([]RelatedTag, error) {
+	endpoint := fmt.Sprintf("/tags/slug/%s/related-tags", url.PathEscape(slug))
+	return c.getRelatedTagRelationships(ctx, endpoint, slug, params)
+}
+
+// getRelatedTagRelationships fetches related tag relationships from endpoint
+func (c *Client) getRelatedTagRelationships(ctx context.Context, endpoint, tag string, params *RelatedTagsParams) ([]RelatedTag, error) {
+	body, err := c.makeRequest(ctx, "GET", endpoint, buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch related tags for %s: %w", tag, err)
+	}
+
+	var related []RelatedTag
+	if err := decodeJSON(body, &related); err != nil {
+		return nil, fmt.Errorf("failed to parse related tags response: %w", err)
+	}
+
+	return related, nil
+}
+
+// GetRelatedTags retrieves the tags related to a tag
+func (c *Client) GetRelatedTags(tagID string, params *RelatedTagsParams) ([]Tag, error) {
+	return c.GetRelatedTagsContext(context.Background(), tagID, params)
+}
+
+// GetRelatedTagsContext is like GetRelatedTags but uses ctx for cancellation and deadlines
+func (c *Client) GetRelatedTagsContext(ctx context.Context, tagID string, params *RelatedTagsParams) ([]Tag, error) {
+	endpoint := fmt.Sprintf("/tags/%s/related-tags/tags", url.PathEscape(tagID))
+	return c.getRelatedTags(ctx, endpoint, tagID, params)
+}
+
+// GetRelatedTagsBySlug retrieves the tags related to a tag given by slug
+func (c *Client) GetRelatedTagsBySlug(slug string, params *RelatedTagsParams) ([]Tag, error) {
+	return c.GetRelatedTagsBySlugContext(context.Background(), slug, params)
+}
+
+// GetRelatedTagsBySlugContext is like GetRelatedTagsBySlug but uses ctx for cancellation and deadlines
+func (c *Client) GetRelatedTagsBySlugContext(ctx context.Context, slug string, params *RelatedTagsParams) ([]Tag, error) {
+	endpoint := fmt.Sprintf("/tags/slug/%s/related-tags/tags", url.PathEscape(slug))
+	return c.getRelatedTags(ctx, endpoint, slug, params)
+}
+
+// getRelatedTags fetches related tags from endpoint
+func (c *Client) getRelatedTags(ctx context.Context, endpoint, tag string, params *RelatedTagsParams) ([]Tag, error) {
+	body, err := c.makeRequest(ctx, "GET", endpoint, buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch related tags for %s: %w", tag, err)
+	}
+
+	var tags []Tag
+	if err := decodeJSON(body, &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse related tags response: %w", err)
+	}
+
+	return tags, nil
+}
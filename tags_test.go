@@ -0,0 +1,132 @@
+package polymarket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// routeServer answers requests whose escaped path and query match a key of
+// routes with its value, and any other request with 404
+func routeServer(t *testing.T, routes map[string]string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := routes[r.RequestURI]
+		if !ok {
+			t.Logf("unexpected request %s", r.RequestURI)
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetTags(t *testing.T) {
+	srv := routeServer(t, map[string]string{
+		"/tags?ascending=false&is_carousel=true&limit=2&order=id": `[{"id":"1","label":"Politics","slug":"politics"},{"id":"2","label":"Crypto","slug":"crypto"}]`,
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	tags, err := c.GetTagsContext(context.Background(), &TagsParams{Limit: 2, Order: "id", Ascending: boolPtr(false), IsCarousel: boolPtr(true)})
+	if err != nil {
+		t.Fatalf("GetTags: %v", err)
+	}
+	if len(tags) != 2 || tags[0].Label != "Politics" || tags[1].Slug != "crypto" {
+		t.Errorf("tags = %+v", tags)
+	}
+}
+
+func TestGetTag(t *testing.T) {
+	srv := routeServer(t, map[string]string{
+		"/tags/100":            `{"id":"100","label":"Elections","slug":"elections"}`,
+		"/tags/slug/us%2Fpols": `{"id":"101","label":"US Politics","slug":"us/pols"}`,
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	tag, err := c.GetTagContext(context.Background(), "100")
+	if err != nil {
+		t.Fatalf("GetTag: %v", err)
+	}
+	if tag.ID != "100" || tag.Label != "Elections" {
+		t.Errorf("tag = %+v, want 100 Elections", tag)
+	}
+
+	tag, err = c.GetTagBySlugContext(context.Background(), "us/pols")
+	if err != nil {
+		t.Fatalf("GetTagBySlug: %v", err)
+	}
+	if tag.ID != "101" {
+		t.Errorf("tag = %+v, want 101", tag)
+	}
+
+	if _, err := c.GetTagContext(context.Background(), "999"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("missing tag: error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestGetTagEscapesID(t *testing.T) {
+	srv := routeServer(t, map[string]string{
+		"/tags/1%2F2":                          `{"id":"1/2"}`,
+		"/tags/1%3Fx=1/related-tags":           `[]`,
+		"/tags/..%2Fmarkets/related-tags/tags": `[]`,
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+
+	if _, err := c.GetTagContext(context.Background(), "1/2"); err != nil {
+		t.Errorf("GetTag: %v", err)
+	}
+	if _, err := c.GetRelatedTagRelationshipsContext(context.Background(), "1?x=1", nil); err != nil {
+		t.Errorf("GetRelatedTagRelationships: %v", err)
+	}
+	if _, err := c.GetRelatedTagsContext(context.Background(), "../markets", nil); err != nil {
+		t.Errorf("GetRelatedTags: %v", err)
+	}
+}
+
+func TestGetRelatedTags(t *testing.T) {
+	srv := routeServer(t, map[string]string{
+		"/tags/100/related-tags?omit_empty=true&status=active": `[{"id":"7","tagID":100,"relatedTagID":200,"rank":1}]`,
+		"/tags/slug/elections/related-tags":                    `[{"id":"8","tagID":100,"relatedTagID":300,"rank":2}]`,
+		"/tags/100/related-tags/tags?status=all":               `[{"id":"200","label":"Senate"}]`,
+		"/tags/slug/elections/related-tags/tags":               `[{"id":"300","label":"House"}]`,
+	})
+	c := NewClient(WithBaseURL(srv.URL))
+	ctx := context.Background()
+
+	relationships, err := c.GetRelatedTagRelationshipsContext(ctx, "100", &RelatedTagsParams{OmitEmpty: boolPtr(true), Status: "active"})
+	if err != nil {
+		t.Fatalf("GetRelatedTagRelationships: %v", err)
+	}
+	if len(relationships) != 1 || relationships[0].TagID != 100 || relationships[0].RelatedTagID != 200 || relationships[0].Rank != 1 {
+		t.Errorf("relationships = %+v", relationships)
+	}
+
+	relationships, err = c.GetRelatedTagRelationshipsBySlugContext(ctx, "elections", nil)
+	if err != nil {
+		t.Fatalf("GetRelatedTagRelationshipsBySlug: %v", err)
+	}
+	if len(relationships) != 1 || relationships[0].RelatedTagID != 300 {
+		t.Errorf("relationships by slug = %+v", relationships)
+	}
+
+	tags, err := c.GetRelatedTagsContext(ctx, "100", &RelatedTagsParams{Status: "all"})
+	if err != nil {
+		t.Fatalf("GetRelatedTags: %v", err)
+	}
+	if len(tags) != 1 || tags[0].Label != "Senate" {
+		t.Errorf("related tags = %+v", tags)
+	}
+
+	tags, err = c.GetRelatedTagsBySlugContext(ctx, "elections", nil)
+	if err != nil {
+		t.Fatalf("GetRelatedTagsBySlug: %v", err)
+	}
+	if len(tags) != 1 || tags[0].Label != "House" {
+		t.Errorf("related tags by slug = %+v", tags)
+	}
+}
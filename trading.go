@@ -0,0 +1,404 @@
+package polymarket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrOrderRejected is returned when the CLOB accepts a request but rejects the order
+var ErrOrderRejected = errors.New("order rejected")
+
+// Cursors delimiting paginated CLOB responses
+const (
+	clobFirstCursor = "MA=="
+	clobEndCursor   = "LTE="
+)
+
+// Order statuses reported in OrderResponse.Status
+const (
+	OrderStatusLive      = "live"      // Resting on the book
+	OrderStatusMatched   = "matched"   // Matched immediately
+	OrderStatusDelayed   = "delayed"   // Marketable, but matching is delayed
+	OrderStatusUnmatched = "unmatched" // Marketable, but could not be matched after a delay
+)
+
+// OrderResponse is the result of posting an order
+type OrderResponse struct {
+	Success            bool     `json:"success"`
+	ErrorMsg           string   `json:"errorMsg"`
+	OrderID            string   `json:"orderID"`
+	Status             string   `json:"status"`
+	TransactionsHashes []string `json:"transactionsHashes"`
+	TakingAmount       Decimal  `json:"takingAmount"`
+	MakingAmount       Decimal  `json:"makingAmount"`
+}
+
+// CancelResponse lists the orders a cancellation removed and the reasons
+// others could not be cancelled, keyed by order ID
+type CancelResponse struct {
+	Canceled    []string          `json:"canceled"`
+	NotCanceled map[string]string `json:"not_canceled"`
+}
+
+// OpenOrder is an order of the authenticated user
+type OpenOrder struct {
+	ID              string    `json:"id"`
+	Status          string    `json:"status"`
+	Owner           string    `json:"owner"` // API key
+	MakerAddress    string    `json:"maker_address"`
+	Market          string    `json:"market"`   // Condition ID
+	AssetID         string    `json:"asset_id"` // Token ID
+	Outcome         string    `json:"outcome"`
+	Side            Side      `json:"side"`
+	Price           Decimal   `json:"price"`
+	OriginalSize    Decimal   `json:"original_size"`
+	SizeMatched     Decimal   `json:"size_matched"`
+	AssociateTrades []string  `json:"associate_trades"`
+	OrderType       OrderType `json:"order_type"`
+	Expiration      UnixTime  `json:"expiration"` // 0 for none
+	CreatedAt       UnixTime  `json:"created_at"`
+}
+
+// UnixTime is a Unix timestamp in seconds. It decodes from a number, a
+// quoted number, an empty string or null, the last two as zero.
+type UnixTime int64
+
+// UnmarshalJSON accepts numbers, quoted numbers, empty strings and null
+func (t *UnixTime) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if s == "null" {
+		*t = 0
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return fmt.Errorf("invalid timestamp %s", s)
+		}
+		s = strings.TrimSpace(unquoted)
+		if s == "" {
+			*t = 0
+			return nil
+		}
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %s", s)
+	}
+	*t = UnixTime(n)
+	return nil
+}
+
+// Time returns t as a UTC time, or the zero time if t is 0
+func (t UnixTime) Time() time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(t), 0).UTC()
+}
+
+// MakerOrder is a resting order matched by a trade
+type MakerOrder struct {
+	OrderID       string  `json:"order_id"`
+	Owner         string  `json:"owner"`
+	MakerAddress  string  `json:"maker_address"`
+	AssetID       string  `json:"asset_id"`
+	Outcome       string  `json:"outcome"`
+	Side          Side    `json:"side"`
+	Price         Decimal `json:"price"`
+	MatchedAmount Decimal `json:"matched_amount"`
+	FeeRateBps    Decimal `json:"fee_rate_bps"`
+}
+
+// UserTrade is a trade involving the authenticated user's orders
+type UserTrade struct {
+	ID              string       `json:"id"`
+	TakerOrderID    string       `json:"taker_order_id"`
+	Market          string       `json:"market"`   // Condition ID
+	AssetID         string       `json:"asset_id"` // Token ID
+	Outcome         string       `json:"outcome"`
+	Side            Side         `json:"side"`
+	Price           Decimal      `json:"price"`
+	Size            Decimal      `json:"size"`
+	FeeRateBps      Decimal      `json:"fee_rate_bps"`
+	Status          string       `json:"status"`      // MATCHED, MINED, CONFIRMED, RETRYING or FAILED
+	TraderSide      string       `json:"trader_side"` // TAKER or MAKER
+	Owner           string       `json:"owner"`
+	MakerAddress    string       `json:"maker_address"`
+	MakerOrders     []MakerOrder `json:"maker_orders"`
+	TransactionHash string       `json:"transaction_hash"`
+	BucketIndex     int          `json:"bucket_index"`
+	MatchTime       string       `json:"match_time"`
+	LastUpdate      string       `json:"last_update"`
+}
+
+// OpenOrdersParams filters the authenticated user's open orders
+type OpenOrdersParams struct {
+	ID      string `json:"id,omitempty" url:"id,omitempty"`
+	Market  string `json:"market,omitempty" url:"market,omitempty"`     // Condition ID
+	AssetID string `json:"asset_id,omitempty" url:"asset_id,omitempty"` // Token ID
+}
+
+// UserTradesParams filters the authenticated user's trades
+type UserTradesParams struct {
+	ID      string `json:"id,omitempty" url:"id,omitempty"`
+	Taker   string `json:"taker,omitempty" url:"taker,omitempty"`
+	Maker   string `json:"maker,omitempty" url:"maker,omitempty"`
+	Market  string `json:"market,omitempty" url:"market,omitempty"`     // Condition ID
+	AssetID string `json:"asset_id,omitempty" url:"asset_id,omitempty"` // Token ID
+	Before  int64  `json:"before,omitempty" url:"before,omitempty"`     // Unix seconds
+	After   int64  `json:"after,omitempty" url:"after,omitempty"`       // Unix seconds
+}
+
+// PostOrder submits a signed order. If the CLOB rejects the order, the
+// response is returned together with an error wrapping ErrOrderRejected.
+func (c *Client) PostOrder(order *Order, orderType OrderType) (*OrderResponse, error) {
+	return c.PostOrderContext(context.Background(), order, orderType)
+}
+
+// PostOrderContext is like PostOrder but uses ctx for cancellation and deadlines
+func (c *Client) PostOrderContext(ctx context.Context, order *Order, orderType OrderType) (*OrderResponse, error) {
+	submission, err := c.newOrderSubmission(order, orderType)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequestWithBody(ctx, c.clobBaseURL, "POST", "/order", nil, submission)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post order: %w", err)
+	}
+
+	var response OrderResponse
+	if err := decodeJSON(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse order response: %w", err)
+	}
+	if !response.Success {
+		reason := response.ErrorMsg
+		if reason == "" {
+			reason = "order was not accepted"
+		}
+		return &response, fmt.Errorf("%w: %s", ErrOrderRejected, reason)
+	}
+
+	return &response, nil
+}
+
+// PostOrders submits several signed orders in one request. Each order is
+// accepted or rejected independently; check Success and ErrorMsg of each response.
+func (c *Client) PostOrders(orders []*Order, orderType OrderType) ([]OrderResponse, error) {
+	return c.PostOrdersContext(context.Background(), orders, orderType)
+}
+
+// PostOrdersContext is like PostOrders but uses ctx for cancellation and deadlines
+func (c *Client) PostOrdersContext(ctx context.Context, orders []*Order, orderType OrderType) ([]OrderResponse, error) {
+	if len(orders) == 0 {
+		return nil, fmt.Errorf("at least one order is required")
+	}
+
+	submissions := make([]*OrderSubmission, 0, len(orders))
+	for _, order := range orders {
+		submission, err := c.newOrderSubmission(order, orderType)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
+
+	body, err := c.makeRequestWithBody(ctx, c.clobBaseURL, "POST", "/orders", nil, submissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post orders: %w", err)
+	}
+
+	var responses []OrderResponse
+	if err := decodeJSON(body, &responses); err != nil {
+		return nil, fmt.Errorf("failed to parse orders response: %w", err)
+	}
+
+	return responses, nil
+}
+
+// newOrderSubmission validates a signed order and wraps it for submission
+// on behalf of the client's API key
+func (c *Client) newOrderSubmission(order *Order, orderType OrderType) (*OrderSubmission, error) {
+	if err := c.requireCredentials(); err != nil {
+		return nil, err
+	}
+	if order == nil || order.Signature == "" {
+		return nil, fmt.Errorf("a signed order is required")
+	}
+
+	switch orderType {
+	case "":
+		orderType = OrderTypeGTC
+	case OrderTypeGTC, OrderTypeFOK, OrderTypeFAK:
+	case OrderTypeGTD:
+		if order.Expiration == "" || order.Expiration == "0" {
+			return nil, fmt.Errorf("GTD orders require an expiration")
+		}
+	default:
+		return nil, fmt.Errorf("invalid order type %q", orderType)
+	}
+
+	return &OrderSubmission{
+		Order:     order,
+		Owner:     c.credentials.Key,
+		OrderType: orderType,
+	}, nil
+}
+
+// CancelOrder cancels an order by ID
+func (c *Client) CancelOrder(orderID string) (*CancelResponse, error) {
+	return c.CancelOrderContext(context.Background(), orderID)
+}
+
+// CancelOrderContext is like CancelOrder but uses ctx for cancellation and deadlines
+func (c *Client) CancelOrderContext(ctx context.Context, orderID string) (*CancelResponse, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("order ID is required")
+	}
+	return c.cancel(ctx, "/order", map[string]string{"orderID": orderID})
+}
+
+// CancelOrders cancels several orders by ID
+func (c *Client) CancelOrders(orderIDs []string) (*CancelResponse, error) {
+	return c.CancelOrdersContext(context.Background(), orderIDs)
+}
+
+// CancelOrdersContext is like CancelOrders but uses ctx for cancellation and deadlines
+func (c *Client) CancelOrdersContext(ctx context.Context, orderIDs []string) (*CancelResponse, error) {
+	if len(orderIDs) == 0 {
+		return nil, fmt.Errorf("at least one order ID is required")
+	}
+	return c.cancel(ctx, "/orders", orderIDs)
+}
+
+// CancelAll cancels all open orders of the authenticated user
+func (c *Client) CancelAll() (*CancelResponse, error) {
+	return c.CancelAllContext(context.Background())
+}
+
+// CancelAllContext is like CancelAll but uses ctx for cancellation and deadlines
+func (c *Client) CancelAllContext(ctx context.Context) (*CancelResponse, error) {
+	return c.cancel(ctx, "/cancel-all", nil)
+}
+
+// CancelMarketOrders cancels the open orders of a market (condition ID),
+// optionally restricted to one of its tokens
+func (c *Client) CancelMarketOrders(market, assetID string) (*CancelResponse, error) {
+	return c.CancelMarketOrdersContext(context.Background(), market, assetID)
+}
+
+// CancelMarketOrdersContext is like CancelMarketOrders but uses ctx for cancellation and deadlines
+func (c *Client) CancelMarketOrdersContext(ctx context.Context, market, assetID string) (*CancelResponse, error) {
+	if market == "" && assetID == "" {
+		return nil, fmt.Errorf("market or asset ID is required")
+	}
+	return c.cancel(ctx, "/cancel-market-orders", map[string]string{
+		"market":   market,
+		"asset_id": assetID,
+	})
+}
+
+// cancel sends a DELETE request to a cancellation endpoint
+func (c *Client) cancel(ctx context.Context, endpoint string, reqBody interface{}) (*CancelResponse, error) {
+	if err := c.requireCredentials(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequestWithBody(ctx, c.clobBaseURL, "DELETE", endpoint, nil, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel orders: %w", err)
+	}
+
+	var response CancelResponse
+	if err := decodeJSON(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse cancel response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetOrder retrieves one of the authenticated user's orders by ID
+func (c *Client) GetOrder(orderID string) (*OpenOrder, error) {
+	return c.GetOrderContext(context.Background(), orderID)
+}
+
+// GetOrderContext is like GetOrder but uses ctx for cancellation and deadlines
+func (c *Client) GetOrderContext(ctx context.Context, orderID string) (*OpenOrder, error) {
+	if err := c.requireCredentials(); err != nil {
+		return nil, err
+	}
+	if orderID == "" {
+		return nil, fmt.Errorf("order ID is required")
+	}
+
+	var order OpenOrder
+	if err := c.getCLOB(ctx, "/data/order/"+url.PathEscape(orderID), nil, &order); err != nil {
+		return nil, fmt.Errorf("failed to fetch order %s: %w", orderID, err)
+	}
+
+	return &order, nil
+}
+
+// GetOpenOrders retrieves all open orders of the authenticated user, following pagination
+func (c *Client) GetOpenOrders(params *OpenOrdersParams) ([]OpenOrder, error) {
+	return c.GetOpenOrdersContext(context.Background(), params)
+}
+
+// GetOpenOrdersContext is like GetOpenOrders but uses ctx for cancellation and deadlines
+func (c *Client) GetOpenOrdersContext(ctx context.Context, params *OpenOrdersParams) ([]OpenOrder, error) {
+	orders, err := getCLOBPages[OpenOrder](ctx, c, "/data/orders", buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open orders: %w", err)
+	}
+	return orders, nil
+}
+
+// GetUserTrades retrieves all trades of the authenticated user, following pagination
+func (c *Client) GetUserTrades(params *UserTradesParams) ([]UserTrade, error) {
+	return c.GetUserTradesContext(context.Background(), params)
+}
+
+// GetUserTradesContext is like GetUserTrades but uses ctx for cancellation and deadlines
+func (c *Client) GetUserTradesContext(ctx context.Context, params *UserTradesParams) ([]UserTrade, error) {
+	trades, err := getCLOBPages[UserTrade](ctx, c, "/data/trades", buildParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch trades: %w", err)
+	}
+	return trades, nil
+}
+
+// getCLOBPages fetches every page of a cursor-paginated authenticated CLOB endpoint
+func getCLOBPages[T any](ctx context.Context, c *Client, endpoint string, params url.Values) ([]T, error) {
+	if err := c.requireCredentials(); err != nil {
+		return nil, err
+	}
+
+	var results []T
+	cursor := clobFirstCursor
+	for cursor != clobEndCursor {
+		params.Set("next_cursor", cursor)
+
+		var page struct {
+			Data       []T    `json:"data"`
+			NextCursor string `json:"next_cursor"`
+		}
+		if err := c.getCLOB(ctx, endpoint, params, &page); err != nil {
+			return nil, err
+		}
+
+		results = append(results, page.Data...)
+		if page.NextCursor == "" || page.NextCursor == cursor {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	return results, nil
+}
@@ -0,0 +1,388 @@
+package polymarket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+var testCredentials = APICredentials{Key: "test-key", Secret: testSecret, Passphrase: "test-pass"}
+
+// clobRequest is a request received by the fake CLOB
+type clobRequest struct {
+	method, path string
+	query        map[string]string
+	body         []byte
+}
+
+// fakeCLOB is a CLOB server that checks the L2 headers of every request,
+// records it and answers with the response for its method and path
+type fakeCLOB struct {
+	t         *testing.T
+	responses map[string]func(r *http.Request) string
+
+	mu       sync.Mutex
+	requests []clobRequest
+}
+
+// newFakeCLOB starts a fake CLOB and returns it with a client authenticated against it
+func newFakeCLOB(t *testing.T, responses map[string]func(r *http.Request) string) (*fakeCLOB, *Client) {
+	t.Helper()
+
+	f := &fakeCLOB{t: t, responses: responses}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return f, NewClient(WithCLOBBaseURL(srv.URL), WithL2Auth(testAddress, testCredentials))
+}
+
+func (f *fakeCLOB) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	f.checkHeaders(r, body)
+
+	query := map[string]string{}
+	for key := range r.URL.Query() {
+		query[key] = r.URL.Query().Get(key)
+	}
+	f.mu.Lock()
+	f.requests = append(f.requests, clobRequest{r.Method, r.URL.Path, query, body})
+	f.mu.Unlock()
+
+	respond, ok := f.responses[r.Method+" "+r.URL.Path]
+	if !ok {
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Write([]byte(respond(r)))
+}
+
+// checkHeaders verifies the POLY_* headers and the signature of a request
+func (f *fakeCLOB) checkHeaders(r *http.Request, body []byte) {
+	h := r.Header
+	if h.Get("POLY_ADDRESS") != testAddress || h.Get("POLY_API_KEY") != testCredentials.Key || h.Get("POLY_PASSPHRASE") != testCredentials.Passphrase {
+		f.t.Errorf("%s %s: POLY_ADDRESS %q, POLY_API_KEY %q, POLY_PASSPHRASE %q",
+			r.Method, r.URL.Path, h.Get("POLY_ADDRESS"), h.Get("POLY_API_KEY"), h.Get("POLY_PASSPHRASE"))
+	}
+	timestamp, err := strconv.ParseInt(h.Get("POLY_TIMESTAMP"), 10, 64)
+	if err != nil {
+		f.t.Errorf("%s %s: POLY_TIMESTAMP = %q", r.Method, r.URL.Path, h.Get("POLY_TIMESTAMP"))
+		return
+	}
+	want, _ := SignL2Request(testCredentials.Secret, timestamp, r.Method, r.URL.Path, body)
+	if got := h.Get("POLY_SIGNATURE"); got != want {
+		f.t.Errorf("%s %s: POLY_SIGNATURE = %s, want %s", r.Method, r.URL.Path, got, want)
+	}
+}
+
+// received returns the recorded requests
+func (f *fakeCLOB) received() []clobRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]clobRequest(nil), f.requests...)
+}
+
+// reply returns a response function answering with a fixed body
+func reply(body string) func(*http.Request) string {
+	return func(*http.Request) string { return body }
+}
+
+// testOrder builds a signed order with the test key
+func testOrder(t *testing.T, side Side) *Order {
+	t.Helper()
+
+	signer, err := NewSigner(testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	order, err := NewOrderBuilder(signer, SignatureEOA, "").BuildOrder(OrderArgs{
+		TokenID:  "1234",
+		Side:     side,
+		Price:    MustParseDecimal("0.5"),
+		Size:     MustParseDecimal("10"),
+		TickSize: MustParseDecimal("0.01"),
+	})
+	if err != nil {
+		t.Fatalf("BuildOrder: %v", err)
+	}
+	return order
+}
+
+// checkSubmission checks the JSON shape of an order submission
+func checkSubmission(t *testing.T, raw json.RawMessage, order *Order, orderType OrderType) {
+	t.Helper()
+
+	var submission map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &submission); err != nil {
+		t.Fatalf("decoding submission %s: %v", raw, err)
+	}
+	if len(submission) != 3 {
+		t.Errorf("submission has fields %v, want order, owner and orderType", reflect.ValueOf(submission).MapKeys())
+	}
+	if string(submission["owner"]) != `"test-key"` {
+		t.Errorf("owner = %s, want the API key", submission["owner"])
+	}
+	if string(submission["orderType"]) != strconv.Quote(string(orderType)) {
+		t.Errorf("orderType = %s, want %s", submission["orderType"], orderType)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(submission["order"], &fields); err != nil {
+		t.Fatalf("decoding order %s: %v", submission["order"], err)
+	}
+	want := map[string]interface{}{
+		"salt":          float64(order.Salt),
+		"maker":         testAddress,
+		"signer":        testAddress,
+		"taker":         ZeroAddress,
+		"tokenId":       "1234",
+		"makerAmount":   order.MakerAmount,
+		"takerAmount":   order.TakerAmount,
+		"expiration":    "0",
+		"nonce":         "0",
+		"feeRateBps":    "0",
+		"side":          string(order.Side),
+		"signatureType": float64(0),
+		"signature":     order.Signature,
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("order = %v, want %v", fields, want)
+	}
+}
+
+func TestPostOrderBody(t *testing.T) {
+	f, c := newFakeCLOB(t, map[string]func(*http.Request) string{
+		"POST /order": reply(`{"success":true,"errorMsg":"","orderID":"0xabc","status":"live","takingAmount":"","makingAmount":""}`),
+	})
+	order := testOrder(t, SideBuy)
+
+	resp, err := c.PostOrderContext(context.Background(), order, OrderTypeFOK)
+	if err != nil {
+		t.Fatalf("PostOrder: %v", err)
+	}
+	if resp.OrderID != "0xabc" || resp.Status != OrderStatusLive {
+		t.Errorf("response = %+v", resp)
+	}
+
+	requests := f.received()
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+	checkSubmission(t, requests[0].body, order, OrderTypeFOK)
+}
+
+func TestPostOrdersBody(t *testing.T) {
+	f, c := newFakeCLOB(t, map[string]func(*http.Request) string{
+		"POST /orders": reply(`[{"success":true,"orderID":"0x1"},{"success":false,"errorMsg":"not enough balance"}]`),
+	})
+	orders := []*Order{testOrder(t, SideBuy), testOrder(t, SideSell)}
+
+	responses, err := c.PostOrdersContext(context.Background(), orders, "")
+	if err != nil {
+		t.Fatalf("PostOrders: %v", err)
+	}
+	if len(responses) != 2 || !responses[0].Success || responses[1].ErrorMsg != "not enough balance" {
+		t.Errorf("responses = %+v", responses)
+	}
+
+	var submissions []json.RawMessage
+	if err := json.Unmarshal(f.received()[0].body, &submissions); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	if len(submissions) != 2 {
+		t.Fatalf("got %d submissions, want 2", len(submissions))
+	}
+	for i, raw := range submissions {
+		checkSubmission(t, raw, orders[i], OrderTypeGTC)
+	}
+}
+
+func TestPostOrderRejected(t *testing.T) {
+	tests := []struct {
+		response string
+		want     string
+	}{
+		{`{"success":false,"errorMsg":"not enough balance / allowance"}`, "order rejected: not enough balance / allowance"},
+		{`{"success":false,"errorMsg":""}`, "order rejected: order was not accepted"},
+		{`{"success":false}`, "order rejected: order was not accepted"},
+	}
+	for _, tt := range tests {
+		_, c := newFakeCLOB(t, map[string]func(*http.Request) string{"POST /order": reply(tt.response)})
+
+		resp, err := c.PostOrderContext(context.Background(), testOrder(t, SideBuy), OrderTypeGTC)
+		if !errors.Is(err, ErrOrderRejected) {
+			t.Errorf("%s: error = %v, want ErrOrderRejected", tt.response, err)
+			continue
+		}
+		if err.Error() != tt.want {
+			t.Errorf("%s: error = %q, want %q", tt.response, err, tt.want)
+		}
+		if resp == nil || resp.Success {
+			t.Errorf("%s: response = %+v, want the rejected response", tt.response, resp)
+		}
+	}
+}
+
+func TestCancelBodies(t *testing.T) {
+	cancelled := reply(`{"canceled":["0x1"],"not_canceled":{"0x2":"order not found"}}`)
+	f, c := newFakeCLOB(t, map[string]func(*http.Request) string{
+		"DELETE /order":                cancelled,
+		"DELETE /orders":               cancelled,
+		"DELETE /cancel-all":           cancelled,
+		"DELETE /cancel-market-orders": cancelled,
+	})
+
+	tests := []struct {
+		name     string
+		cancel   func() (*CancelResponse, error)
+		path     string
+		wantBody string
+	}{
+		{"CancelOrder", func() (*CancelResponse, error) { return c.CancelOrder("0x1") }, "/order", `{"orderID":"0x1"}`},
+		{"CancelOrders", func() (*CancelResponse, error) { return c.CancelOrders([]string{"0x1", "0x2"}) }, "/orders", `["0x1","0x2"]`},
+		{"CancelAll", c.CancelAll, "/cancel-all", ``},
+		{"CancelMarketOrders", func() (*CancelResponse, error) { return c.CancelMarketOrders("0xcond", "1234") }, "/cancel-market-orders", `{"asset_id":"1234","market":"0xcond"}`},
+	}
+	for i, tt := range tests {
+		resp, err := tt.cancel()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if len(resp.Canceled) != 1 || resp.NotCanceled["0x2"] != "order not found" {
+			t.Errorf("%s: response = %+v", tt.name, resp)
+		}
+
+		req := f.received()[i]
+		if req.method != "DELETE" || req.path != tt.path {
+			t.Errorf("%s: request = %s %s, want DELETE %s", tt.name, req.method, req.path, tt.path)
+		}
+		if string(req.body) != tt.wantBody {
+			t.Errorf("%s: body = %s, want %s", tt.name, req.body, tt.wantBody)
+		}
+	}
+}
+
+func TestGetOpenOrdersFollowsCursorUntilEnd(t *testing.T) {
+	pages := map[string]string{
+		"MA==": `{"data":[{"id":"0x1","expiration":"","created_at":1712345678}],"next_cursor":"MTAw"}`,
+		"MTAw": `{"data":[{"id":"0x2","expiration":"1735689600","created_at":"1712345679"}],"next_cursor":"MjAw"}`,
+		"MjAw": `{"data":[{"id":"0x3","expiration":0,"created_at":null}],"next_cursor":"LTE="}`,
+	}
+	f, c := newFakeCLOB(t, map[string]func(*http.Request) string{
+		"GET /data/orders": func(r *http.Request) string {
+			page, ok := pages[r.URL.Query().Get("next_cursor")]
+			if !ok {
+				t.Errorf("unexpected cursor %q", r.URL.Query().Get("next_cursor"))
+				return `{"data":[],"next_cursor":"LTE="}`
+			}
+			return page
+		},
+	})
+
+	orders, err := c.GetOpenOrdersContext(context.Background(), &OpenOrdersParams{Market: "0xcond"})
+	if err != nil {
+		t.Fatalf("GetOpenOrders: %v", err)
+	}
+
+	if len(orders) != 3 || orders[0].ID != "0x1" || orders[1].ID != "0x2" || orders[2].ID != "0x3" {
+		t.Fatalf("orders = %+v", orders)
+	}
+	times := []UnixTime{orders[0].Expiration, orders[0].CreatedAt, orders[1].Expiration, orders[1].CreatedAt, orders[2].Expiration, orders[2].CreatedAt}
+	if want := []UnixTime{0, 1712345678, 1735689600, 1712345679, 0, 0}; !reflect.DeepEqual(times, want) {
+		t.Errorf("expiration/created_at = %v, want %v", times, want)
+	}
+
+	requests := f.received()
+	cursors := make([]string, len(requests))
+	for i, req := range requests {
+		cursors[i] = req.query["next_cursor"]
+		if req.query["market"] != "0xcond" {
+			t.Errorf("request %d: market = %q, want 0xcond", i, req.query["market"])
+		}
+	}
+	if want := []string{"MA==", "MTAw", "MjAw"}; !reflect.DeepEqual(cursors, want) {
+		t.Errorf("cursors = %v, want %v", cursors, want)
+	}
+}
+
+func TestGetUserTradesFollowsCursorUntilEnd(t *testing.T) {
+	f, c := newFakeCLOB(t, map[string]func(*http.Request) string{
+		"GET /data/trades": func(r *http.Request) string {
+			if r.URL.Query().Get("next_cursor") == "MA==" {
+				return `{"data":[{"id":"t1"},{"id":"t2"}],"next_cursor":"Mg=="}`
+			}
+			return `{"data":[{"id":"t3"}],"next_cursor":"LTE="}`
+		},
+	})
+
+	trades, err := c.GetUserTradesContext(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("GetUserTrades: %v", err)
+	}
+	if len(trades) != 3 || trades[2].ID != "t3" {
+		t.Errorf("trades = %+v", trades)
+	}
+	if got := len(f.received()); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestUnixTimeUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want UnixTime
+	}{
+		{`1712345678`, 1712345678},
+		{`"1712345678"`, 1712345678},
+		{`""`, 0},
+		{`" "`, 0},
+		{`null`, 0},
+		{`0`, 0},
+	}
+	for _, tt := range tests {
+		var got UnixTime
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Unmarshal(%s) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{`"abc"`, `1.5`, `true`, `{}`} {
+		var got UnixTime
+		if err := json.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s) = %d, want error", in, got)
+		}
+	}
+
+	if got := UnixTime(1712345678).Time().Format("2006-01-02T15:04:05Z07:00"); got != "2024-04-05T19:34:38Z" {
+		t.Errorf("Time = %s", got)
+	}
+	if !UnixTime(0).Time().IsZero() {
+		t.Error("UnixTime(0).Time() is not the zero time")
+	}
+}
+
+func TestOrderEventEmptyExpiration(t *testing.T) {
+	event, err := decodeUserEvent([]byte(`{"event_type":"order","type":"PLACEMENT","id":"0x1",
+		"expiration":"","created_at":"1712345678","timestamp":"1712345678123"}`))
+	if err != nil {
+		t.Fatalf("decodeUserEvent: %v", err)
+	}
+	order, ok := event.(*OrderEvent)
+	if !ok {
+		t.Fatalf("event = %#v, want *OrderEvent", event)
+	}
+	if order.ID != "0x1" || order.Expiration != 0 || order.CreatedAt != 1712345678 || order.Type != OrderEventPlacement {
+		t.Errorf("event = %+v", order)
+	}
+}
@@ -1,6 +1,9 @@
 package polymarket
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Market represents a single event outcome on Polymarket
 type Market struct {
@@ -14,17 +17,73 @@ type Market struct {
 	Icon               string     `json:"icon"`
 	Active             bool       `json:"active"`
 	Closed             bool       `json:"closed"`
+	Archived           bool       `json:"archived"`
+	Featured           bool       `json:"featured"`
+	New                bool       `json:"new"`
+	Restricted         bool       `json:"restricted"`
 	MarketMakerAddress string     `json:"marketMakerAddress"`
 
-	// Market type and outcomes
+	// Grouping within a multi-market event
+	GroupItemTitle     string `json:"groupItemTitle"`
+	GroupItemThreshold string `json:"groupItemThreshold"`
+
+	// Market type and outcomes, JSON-encoded as strings by the API.
+	// Use ParsedOutcomes to decode them.
 	MarketType     string `json:"marketType"`
 	Outcomes       string `json:"outcomes"`
 	OutcomesPrices string `json:"outcomePrices"`
+	ClobTokenIDs   string `json:"clobTokenIds"`
 
 	// Trading information
-	Volume       string  `json:"volume"`
-	Volume24hr   float64 `json:"volume24hr"`
-	LiquidityNum float64 `json:"liquidityNum"`
+	Volume         Decimal `json:"volume"`
+	VolumeNum      Decimal `json:"volumeNum"`
+	Volume24hr     Decimal `json:"volume24hr"`
+	Volume1wk      Decimal `json:"volume1wk"`
+	Volume1mo      Decimal `json:"volume1mo"`
+	Volume1yr      Decimal `json:"volume1yr"`
+	VolumeClob     Decimal `json:"volumeClob"`
+	Volume24hrClob Decimal `json:"volume24hrClob"`
+	Liquidity      Decimal `json:"liquidity"`
+	LiquidityNum   Decimal `json:"liquidityNum"`
+	LiquidityClob  Decimal `json:"liquidityClob"`
+	Competitive    Decimal `json:"competitive"`
+
+	// Pricing
+	BestBid             Decimal `json:"bestBid"`
+	BestAsk             Decimal `json:"bestAsk"`
+	Spread              Decimal `json:"spread"`
+	LastTradePrice      Decimal `json:"lastTradePrice"`
+	OneHourPriceChange  Decimal `json:"oneHourPriceChange"`
+	OneDayPriceChange   Decimal `json:"oneDayPriceChange"`
+	OneWeekPriceChange  Decimal `json:"oneWeekPriceChange"`
+	OneMonthPriceChange Decimal `json:"oneMonthPriceChange"`
+
+	// Order book (CLOB) configuration
+	EnableOrderBook          bool       `json:"enableOrderBook"`
+	AcceptingOrders          bool       `json:"acceptingOrders"`
+	AcceptingOrdersTimestamp *time.Time `json:"acceptingOrdersTimestamp"`
+	OrderPriceMinTickSize    Decimal    `json:"orderPriceMinTickSize"`
+	OrderMinSize             Decimal    `json:"orderMinSize"`
+
+	// Negative risk
+	NegRisk          bool   `json:"negRisk"`
+	NegRiskOther     bool   `json:"negRiskOther"`
+	NegRiskMarketID  string `json:"negRiskMarketID"`
+	NegRiskRequestID string `json:"negRiskRequestID"`
+
+	// Liquidity rewards
+	ClobRewards      []ClobReward `json:"clobRewards"`
+	RewardsMinSize   Decimal      `json:"rewardsMinSize"`
+	RewardsMaxSpread Decimal      `json:"rewardsMaxSpread"`
+
+	// Resolution
+	ResolutionSource      string  `json:"resolutionSource"`
+	ResolvedBy            string  `json:"resolvedBy"`
+	UmaResolutionStatus   string  `json:"umaResolutionStatus"`
+	UmaResolutionStatuses string  `json:"umaResolutionStatuses"` // JSON-encoded list
+	UmaBond               Decimal `json:"umaBond"`
+	UmaReward             Decimal `json:"umaReward"`
+	AutomaticallyActive   bool    `json:"automaticallyActive"`
 
 	// Market structure
 	Tokens     []Token    `json:"tokens"`
@@ -36,25 +95,61 @@ type Market struct {
 	QuestionID  string `json:"questionId"`
 	ConditionID string `json:"conditionId"`
 	UmaAddress  string `json:"umaAddress"`
+	Ready       bool   `json:"ready"`
+	Funded      bool   `json:"funded"`
+	Approved    bool   `json:"approved"`
+	CYOM        bool   `json:"cyom"`
+
+	// Dates as plain YYYY-MM-DD strings
+	StartDateIso string `json:"startDateIso"`
+	EndDateIso   string `json:"endDateIso"`
 
 	// Timestamps
 	CreatedAt *time.Time `json:"createdAt"`
 	UpdatedAt *time.Time `json:"updatedAt"`
+
+	// Raw holds the original JSON object, for fields not modeled above
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes a market and retains the raw JSON in Raw
+func (m *Market) UnmarshalJSON(data []byte) error {
+	type market Market
+	var decoded market
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*m = Market(decoded)
+	m.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// ClobReward describes a liquidity rewards program on a market
+type ClobReward struct {
+	ID               string  `json:"id"`
+	ConditionID      string  `json:"conditionId"`
+	AssetAddress     string  `json:"assetAddress"`
+	RewardsAmount    Decimal `json:"rewardsAmount"`
+	RewardsDailyRate Decimal `json:"rewardsDailyRate"`
+	StartDate        string  `json:"startDate"`
+	EndDate          string  `json:"endDate"`
 }
 
 // Token represents a stake in a specific Yes/No outcome in a Market
 // Price fluctuates between 0-1 and is redeemable for $1 USDC upon resolution
 type Token struct {
-	ID      string `json:"id"`
-	TokenID string `json:"token_id"`
-	Outcome string `json:"outcome"`
-	Price   string `json:"price"`
-	Winner  *bool  `json:"winner"`
+	ID      string  `json:"id"`
+	TokenID string  `json:"token_id"`
+	Outcome string  `json:"outcome"`
+	Price   Decimal `json:"price"`
+	Winner  *bool   `json:"winner"`
 }
 
 // Event represents a collection of related markets
 type Event struct {
 	ID          string     `json:"id"`
+	Ticker      string     `json:"ticker"`
 	Title       string     `json:"title"`
 	Slug        string     `json:"slug"`
 	Description string     `json:"description"`
@@ -66,17 +161,37 @@ type Event struct {
 	Closed      bool       `json:"closed"`
 	Archived    bool       `json:"archived"`
 	Featured    bool       `json:"featured"`
+	New         bool       `json:"new"`
+	Restricted  bool       `json:"restricted"`
 
 	// Trading metrics
-	Volume     float64 `json:"volume"`
-	Volume24hr float64 `json:"volume24hr"`
-	Liquidity  float64 `json:"liquidity"`
+	Volume        Decimal `json:"volume"`
+	Volume24hr    Decimal `json:"volume24hr"`
+	Volume1wk     Decimal `json:"volume1wk"`
+	Volume1mo     Decimal `json:"volume1mo"`
+	Volume1yr     Decimal `json:"volume1yr"`
+	Liquidity     Decimal `json:"liquidity"`
+	LiquidityClob Decimal `json:"liquidityClob"`
+	OpenInterest  Decimal `json:"openInterest"`
+	Competitive   Decimal `json:"competitive"`
 
 	// Event features
 	CommentsEnabled       bool `json:"commentsEnabled"`
+	CommentCount          int  `json:"commentCount"`
+	EnableOrderBook       bool `json:"enableOrderBook"`
 	NegRisk               bool `json:"negRisk"`
+	EnableNegRisk         bool `json:"enableNegRisk"`
+	NegRiskAugmented      bool `json:"negRiskAugmented"`
 	AutomaticallyResolved bool `json:"automaticallyResolved"`
 	CYOM                  bool `json:"cyom"`
+	ShowAllOutcomes       bool `json:"showAllOutcomes"`
+	ShowMarketImages      bool `json:"showMarketImages"`
+
+	// Negative risk
+	NegRiskMarketID string `json:"negRiskMarketID"`
+
+	// Resolution
+	ResolutionSource string `json:"resolutionSource"`
 
 	// Related data
 	Markets    []Market   `json:"markets"`
@@ -88,24 +203,129 @@ type Event struct {
 	Recurrence string `json:"recurrence"`
 
 	// Metadata
-	CreatedAt *time.Time `json:"createdAt"`
-	UpdatedAt *time.Time `json:"updatedAt"`
+	SortBy       string     `json:"sortBy"`
+	CreationDate *time.Time `json:"creationDate"`
+	CreatedAt    *time.Time `json:"createdAt"`
+	UpdatedAt    *time.Time `json:"updatedAt"`
+
+	// Raw holds the original JSON object, for fields not modeled above
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes an event and retains the raw JSON in Raw
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type event Event
+	var decoded event
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*e = Event(decoded)
+	e.Raw = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 // Series represents a series of related events
 type Series struct {
-	ID          string `json:"id"`
-	Title       string `json:"title"`
-	Slug        string `json:"slug"`
-	Description string `json:"description"`
-	Image       string `json:"image"`
-	Active      bool   `json:"active"`
+	ID          string     `json:"id"`
+	Ticker      string     `json:"ticker"`
+	Title       string     `json:"title"`
+	Subtitle    string     `json:"subtitle"`
+	Slug        string     `json:"slug"`
+	Description string     `json:"description"`
+	Image       string     `json:"image"`
+	Icon        string     `json:"icon"`
+	SeriesType  string     `json:"seriesType"`
+	Recurrence  string     `json:"recurrence"`
+	Layout      string     `json:"layout"`
+	Active      bool       `json:"active"`
+	Closed      bool       `json:"closed"`
+	Archived    bool       `json:"archived"`
+	Featured    bool       `json:"featured"`
+	New         bool       `json:"new"`
+	Restricted  bool       `json:"restricted"`
+	StartDate   *time.Time `json:"startDate"`
+
+	// Trading metrics
+	Volume      Decimal `json:"volume"`
+	Volume24hr  Decimal `json:"volume24hr"`
+	Liquidity   Decimal `json:"liquidity"`
+	Competitive Decimal `json:"competitive"`
+
+	// Comments
+	CommentsEnabled bool `json:"commentsEnabled"`
+	CommentCount    int  `json:"commentCount"`
+
+	// Related data
+	Events     []Event    `json:"events"`
+	Categories []Category `json:"categories"`
+	Tags       []Tag      `json:"tags"`
+
+	// Metadata
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// SeriesParams represents query parameters for listing series
+type SeriesParams struct {
+	// Pagination
+	Limit  int `json:"limit,omitempty" url:"limit,omitempty"`
+	Offset int `json:"offset,omitempty" url:"offset,omitempty"`
+
+	// Sorting
+	Order     string `json:"order,omitempty" url:"order,omitempty"`
+	Ascending *bool  `json:"ascending,omitempty" url:"ascending,omitempty"`
+
+	// Filters
+	Slug             []string `json:"slug,omitempty" url:"slug,omitempty"`
+	CategoriesIDs    []int    `json:"categories_ids,omitempty" url:"categories_ids,omitempty"`
+	CategoriesLabels []string `json:"categories_labels,omitempty" url:"categories_labels,omitempty"`
+	Closed           *bool    `json:"closed,omitempty" url:"closed,omitempty"`
+	IncludeChat      *bool    `json:"include_chat,omitempty" url:"include_chat,omitempty"`
+	Recurrence       string   `json:"recurrence,omitempty" url:"recurrence,omitempty"`
 }
 
 // Tag represents an event tag
 type Tag struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Label       string     `json:"label"`
+	Slug        string     `json:"slug"`
+	ForceShow   bool       `json:"forceShow"`
+	ForceHide   bool       `json:"forceHide"`
+	IsCarousel  bool       `json:"isCarousel"`
+	PublishedAt string     `json:"publishedAt"`
+	CreatedAt   *time.Time `json:"createdAt"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+}
+
+// RelatedTag represents a relationship between two tags
+type RelatedTag struct {
+	ID           string `json:"id"`
+	TagID        int    `json:"tagID"`
+	RelatedTagID int    `json:"relatedTagID"`
+	Rank         int    `json:"rank"`
+}
+
+// TagsParams represents query parameters for listing tags
+type TagsParams struct {
+	// Pagination
+	Limit  int `json:"limit,omitempty" url:"limit,omitempty"`
+	Offset int `json:"offset,omitempty" url:"offset,omitempty"`
+
+	// Sorting
+	Order     string `json:"order,omitempty" url:"order,omitempty"`
+	Ascending *bool  `json:"ascending,omitempty" url:"ascending,omitempty"`
+
+	// Filters
+	IncludeTemplate *bool `json:"include_template,omitempty" url:"include_template,omitempty"`
+	IsCarousel      *bool `json:"is_carousel,omitempty" url:"is_carousel,omitempty"`
+}
+
+// RelatedTagsParams represents query parameters for related tag lookups
+type RelatedTagsParams struct {
+	OmitEmpty *bool  `json:"omit_empty,omitempty" url:"omit_empty,omitempty"`
+	Status    string `json:"status,omitempty" url:"status,omitempty"` // "active", "closed" or "all"
 }
 
 // Category represents a market category/tag
@@ -116,61 +336,93 @@ type Category struct {
 
 // MarketsParams represents query parameters for listing markets
 type MarketsParams struct {
-	Limit     int    `json:"limit,omitempty"`
-	Offset    int    `json:"offset,omitempty"`
-	Order     string `json:"order,omitempty"`
-	Ascending bool   `json:"ascending,omitempty"`
+	// Pagination
+	Limit  int `json:"limit,omitempty" url:"limit,omitempty"`
+	Offset int `json:"offset,omitempty" url:"offset,omitempty"`
 
-	// Filters
-	Active   *bool  `json:"active,omitempty"`
-	Closed   *bool  `json:"closed,omitempty"`
-	Archived *bool  `json:"archived,omitempty"`
-	Slug     string `json:"slug,omitempty"`
-	EventID  string `json:"event_id,omitempty"`
-	TagID    string `json:"tag_id,omitempty"`
+	// Sorting
+	Order     string `json:"order,omitempty" url:"order,omitempty"`
+	Ascending *bool  `json:"ascending,omitempty" url:"ascending,omitempty"`
+
+	// Basic filters
+	ID                 []string `json:"id,omitempty" url:"id,omitempty"`
+	Slug               string   `json:"slug,omitempty" url:"slug,omitempty"`
+	Slugs              []string `json:"slugs,omitempty" url:"slug,omitempty"`
+	ClobTokenIDs       []string `json:"clob_token_ids,omitempty" url:"clob_token_ids,omitempty"`
+	ConditionIDs       []string `json:"condition_ids,omitempty" url:"condition_ids,omitempty"`
+	QuestionIDs        []string `json:"question_ids,omitempty" url:"question_ids,omitempty"`
+	MarketMakerAddress []string `json:"market_maker_address,omitempty" url:"market_maker_address,omitempty"`
+	Active             *bool    `json:"active,omitempty" url:"active,omitempty"`
+	Closed             *bool    `json:"closed,omitempty" url:"closed,omitempty"`
+	Archived           *bool    `json:"archived,omitempty" url:"archived,omitempty"`
+	EventID            string   `json:"event_id,omitempty" url:"event_id,omitempty"`
+
+	// Tag filters
+	TagID       string `json:"tag_id,omitempty" url:"tag_id,omitempty"`
+	RelatedTags *bool  `json:"related_tags,omitempty" url:"related_tags,omitempty"`
+	IncludeTag  *bool  `json:"include_tag,omitempty" url:"include_tag,omitempty"`
+
+	// Advanced filters
+	CYOM                *bool    `json:"cyom,omitempty" url:"cyom,omitempty"`
+	UmaResolutionStatus string   `json:"uma_resolution_status,omitempty" url:"uma_resolution_status,omitempty"`
+	GameID              string   `json:"game_id,omitempty" url:"game_id,omitempty"`
+	SportsMarketTypes   []string `json:"sports_market_types,omitempty" url:"sports_market_types,omitempty"`
+	RewardsMinSize      *Decimal `json:"rewards_min_size,omitempty" url:"rewards_min_size,omitempty"`
+
+	// Liquidity and volume ranges
+	LiquidityNumMin *Decimal `json:"liquidity_num_min,omitempty" url:"liquidity_num_min,omitempty"`
+	LiquidityNumMax *Decimal `json:"liquidity_num_max,omitempty" url:"liquidity_num_max,omitempty"`
+	VolumeNumMin    *Decimal `json:"volume_num_min,omitempty" url:"volume_num_min,omitempty"`
+	VolumeNumMax    *Decimal `json:"volume_num_max,omitempty" url:"volume_num_max,omitempty"`
+
+	// Date filters
+	StartDateMin *time.Time `json:"start_date_min,omitempty" url:"start_date_min,omitempty"`
+	StartDateMax *time.Time `json:"start_date_max,omitempty" url:"start_date_max,omitempty"`
+	EndDateMin   *time.Time `json:"end_date_min,omitempty" url:"end_date_min,omitempty"`
+	EndDateMax   *time.Time `json:"end_date_max,omitempty" url:"end_date_max,omitempty"`
 }
 
 // EventsParams represents query parameters for listing events
 type EventsParams struct {
 	// Pagination
-	Limit  int `json:"limit,omitempty"`
-	Offset int `json:"offset,omitempty"`
+	Limit  int `json:"limit,omitempty" url:"limit,omitempty"`
+	Offset int `json:"offset,omitempty" url:"offset,omitempty"`
 
 	// Sorting
-	Order     string `json:"order,omitempty"`
-	Ascending bool   `json:"ascending,omitempty"`
+	Order     string `json:"order,omitempty" url:"order,omitempty"`
+	Ascending *bool  `json:"ascending,omitempty" url:"ascending,omitempty"`
 
 	// Basic filters
-	ID       []string `json:"id,omitempty"`
-	Slug     []string `json:"slug,omitempty"`
-	Active   *bool    `json:"active,omitempty"`
-	Closed   *bool    `json:"closed,omitempty"`
-	Archived *bool    `json:"archived,omitempty"`
+	ID       []string `json:"id,omitempty" url:"id,omitempty"`
+	Slug     []string `json:"slug,omitempty" url:"slug,omitempty"`
+	Active   *bool    `json:"active,omitempty" url:"active,omitempty"`
+	Closed   *bool    `json:"closed,omitempty" url:"closed,omitempty"`
+	Archived *bool    `json:"archived,omitempty" url:"archived,omitempty"`
 
 	// Advanced filters
-	TagID        *int   `json:"tag_id,omitempty"`
-	ExcludeTagID []int  `json:"exclude_tag_id,omitempty"`
-	RelatedTags  *bool  `json:"related_tags,omitempty"`
-	Featured     *bool  `json:"featured,omitempty"`
-	CYOM         *bool  `json:"cyom,omitempty"`
-	Recurrence   string `json:"recurrence,omitempty"`
+	TagID        *int   `json:"tag_id,omitempty" url:"tag_id,omitempty"`
+	ExcludeTagID []int  `json:"exclude_tag_id,omitempty" url:"exclude_tag_id,omitempty"`
+	RelatedTags  *bool  `json:"related_tags,omitempty" url:"related_tags,omitempty"`
+	Featured     *bool  `json:"featured,omitempty" url:"featured,omitempty"`
+	CYOM         *bool  `json:"cyom,omitempty" url:"cyom,omitempty"`
+	Recurrence   string `json:"recurrence,omitempty" url:"recurrence,omitempty"`
 
 	// Date filters
-	StartDateMin *time.Time `json:"start_date_min,omitempty"`
-	StartDateMax *time.Time `json:"start_date_max,omitempty"`
-	EndDateMin   *time.Time `json:"end_date_min,omitempty"`
-	EndDateMax   *time.Time `json:"end_date_max,omitempty"`
+	StartDateMin *time.Time `json:"start_date_min,omitempty" url:"start_date_min,omitempty"`
+	StartDateMax *time.Time `json:"start_date_max,omitempty" url:"start_date_max,omitempty"`
+	EndDateMin   *time.Time `json:"end_date_min,omitempty" url:"end_date_min,omitempty"`
+	EndDateMax   *time.Time `json:"end_date_max,omitempty" url:"end_date_max,omitempty"`
 }
 
 // GetEventParams represents query parameters for getting a single event by ID
 type GetEventParams struct {
-	IncludeChat     *bool `json:"include_chat,omitempty"`
-	IncludeTemplate *bool `json:"include_template,omitempty"`
+	IncludeChat     *bool `json:"include_chat,omitempty" url:"include_chat,omitempty"`
+	IncludeTemplate *bool `json:"include_template,omitempty" url:"include_template,omitempty"`
 }
 
 // GetMarketParams represents query parameters for getting a single market by ID
 type GetMarketParams struct {
-	IncludeTag *bool `json:"include_tag,omitempty"`
+	IncludeTag *bool `json:"include_tag,omitempty" url:"include_tag,omitempty"`
 }
 
 // Comment represents a comment on a market, event, or series
@@ -198,6 +450,39 @@ type UserProfile struct {
 	Verified    bool   `json:"verified"`
 }
 
+// PublicProfile represents the public profile of a wallet
+type PublicProfile struct {
+	ProxyWallet           string        `json:"proxyWallet"`
+	Name                  string        `json:"name"`
+	Pseudonym             string        `json:"pseudonym"`
+	Bio                   string        `json:"bio"`
+	ProfileImage          string        `json:"profileImage"`
+	XUsername             string        `json:"xUsername"`
+	VerifiedBadge         bool          `json:"verifiedBadge"`
+	DisplayUsernamePublic bool          `json:"displayUsernamePublic"`
+	CreatedAt             *time.Time    `json:"createdAt"`
+	Users                 []ProfileUser `json:"users"`
+}
+
+// ProfileUser is a Polymarket account linked to a public profile
+type ProfileUser struct {
+	ID      string `json:"id"`
+	Creator bool   `json:"creator"`
+	Mod     bool   `json:"mod"`
+}
+
+// DisplayName returns the name to show for the profile: the username when
+// it is public, otherwise the pseudonym, falling back to the wallet address
+func (p *PublicProfile) DisplayName() string {
+	if p.DisplayUsernamePublic && p.Name != "" {
+		return p.Name
+	}
+	if p.Pseudonym != "" {
+		return p.Pseudonym
+	}
+	return p.ProxyWallet
+}
+
 // Reaction represents a reaction to a comment
 type Reaction struct {
 	ID          string     `json:"id"`
@@ -209,43 +494,43 @@ type Reaction struct {
 // CommentsParams represents query parameters for listing comments
 type CommentsParams struct {
 	// Pagination
-	Limit  int `json:"limit,omitempty"`
-	Offset int `json:"offset,omitempty"`
+	Limit  int `json:"limit,omitempty" url:"limit,omitempty"`
+	Offset int `json:"offset,omitempty" url:"offset,omitempty"`
 
 	// Sorting
-	Order     string `json:"order,omitempty"`
-	Ascending bool   `json:"ascending,omitempty"`
+	Order     string `json:"order,omitempty" url:"order,omitempty"`
+	Ascending *bool  `json:"ascending,omitempty" url:"ascending,omitempty"`
 
 	// Filters
-	ParentEntityType string `json:"parent_entity_type,omitempty"` // "Event", "Series", "market"
-	ParentEntityID   *int   `json:"parent_entity_id,omitempty"`
-	GetPositions     *bool  `json:"get_positions,omitempty"`
-	HoldersOnly      *bool  `json:"holders_only,omitempty"`
+	ParentEntityType string `json:"parent_entity_type,omitempty" url:"parent_entity_type,omitempty"` // "Event", "Series", "market"
+	ParentEntityID   *int   `json:"parent_entity_id,omitempty" url:"parent_entity_id,omitempty"`
+	GetPositions     *bool  `json:"get_positions,omitempty" url:"get_positions,omitempty"`
+	HoldersOnly      *bool  `json:"holders_only,omitempty" url:"holders_only,omitempty"`
 }
 
 // SearchParams represents query parameters for search
 type SearchParams struct {
 	// Required
-	Q string `json:"q"` // Search query
+	Q string `json:"q" url:"q,omitempty"` // Search query
 
 	// Pagination
-	Page         int `json:"page,omitempty"`
-	LimitPerType int `json:"limit_per_type,omitempty"`
+	Page         int `json:"page,omitempty" url:"page,omitempty"`
+	LimitPerType int `json:"limit_per_type,omitempty" url:"limit_per_type,omitempty"`
 
 	// Sorting
-	Sort      string `json:"sort,omitempty"`
-	Ascending bool   `json:"ascending,omitempty"`
+	Sort      string `json:"sort,omitempty" url:"sort,omitempty"`
+	Ascending *bool  `json:"ascending,omitempty" url:"ascending,omitempty"`
 
 	// Filters and options
-	Cache             *bool    `json:"cache,omitempty"`
-	EventsStatus      string   `json:"events_status,omitempty"`
-	EventsTag         []string `json:"events_tag,omitempty"`
-	KeepClosedMarkets *int     `json:"keep_closed_markets,omitempty"`
-	SearchTags        *bool    `json:"search_tags,omitempty"`
-	SearchProfiles    *bool    `json:"search_profiles,omitempty"`
-	Recurrence        string   `json:"recurrence,omitempty"`
-	ExcludeTagID      []int    `json:"exclude_tag_id,omitempty"`
-	Optimized         *bool    `json:"optimized,omitempty"`
+	Cache             *bool    `json:"cache,omitempty" url:"cache,omitempty"`
+	EventsStatus      string   `json:"events_status,omitempty" url:"events_status,omitempty"`
+	EventsTag         []string `json:"events_tag,omitempty" url:"events_tag,omitempty"`
+	KeepClosedMarkets *int     `json:"keep_closed_markets,omitempty" url:"keep_closed_markets,omitempty"`
+	SearchTags        *bool    `json:"search_tags,omitempty" url:"search_tags,omitempty"`
+	SearchProfiles    *bool    `json:"search_profiles,omitempty" url:"search_profiles,omitempty"`
+	Recurrence        string   `json:"recurrence,omitempty" url:"recurrence,omitempty"`
+	ExcludeTagID      []int    `json:"exclude_tag_id,omitempty" url:"exclude_tag_id,omitempty"`
+	Optimized         *bool    `json:"optimized,omitempty" url:"optimized,omitempty"`
 }
 
 // SearchResults represents the unified search response
@@ -264,22 +549,330 @@ type Pagination struct {
 
 // LiveVolume represents live volume data for an event
 type LiveVolume struct {
-	Total   float64        `json:"total"`
+	Total   Decimal        `json:"total"`
 	Markets []MarketVolume `json:"markets"`
 }
 
 // MarketVolume represents volume data for a specific market
 type MarketVolume struct {
 	Market string  `json:"market"` // Market address/ID
-	Value  float64 `json:"value"`  // Volume value
+	Value  Decimal `json:"value"`  // Volume value
+}
+
+// Side is the side of an order or trade
+type Side string
+
+const (
+	SideBuy  Side = "BUY"
+	SideSell Side = "SELL"
+)
+
+// PriceLevel is an aggregated price level in an order book
+type PriceLevel struct {
+	Price Decimal `json:"price"`
+	Size  Decimal `json:"size"`
+}
+
+// OrderBook is a snapshot of the CLOB order book for a single token
+type OrderBook struct {
+	Market       string       `json:"market"`   // Condition ID
+	AssetID      string       `json:"asset_id"` // Token ID
+	Hash         string       `json:"hash"`
+	Timestamp    string       `json:"timestamp"` // Unix milliseconds
+	Bids         []PriceLevel `json:"bids"`
+	Asks         []PriceLevel `json:"asks"`
+	MinOrderSize Decimal      `json:"min_order_size"`
+	TickSize     Decimal      `json:"tick_size"`
+	NegRisk      bool         `json:"neg_risk"`
+}
+
+// LastTradePrice is the price and side of the most recent trade of a token
+type LastTradePrice struct {
+	Price Decimal `json:"price"`
+	Side  Side    `json:"side"`
+}
+
+// Price history intervals accepted by PriceHistoryParams.Interval
+const (
+	PriceHistoryInterval1m  = "1m"
+	PriceHistoryInterval1h  = "1h"
+	PriceHistoryInterval6h  = "6h"
+	PriceHistoryInterval1d  = "1d"
+	PriceHistoryInterval1w  = "1w"
+	PriceHistoryIntervalMax = "max"
+)
+
+// PriceHistoryParams represents query parameters for a token's price history.
+// Use either Interval or a StartTs/EndTs range.
+type PriceHistoryParams struct {
+	Interval string `json:"interval,omitempty" url:"interval,omitempty"`
+	StartTs  int64  `json:"startTs,omitempty" url:"startTs,omitempty"`   // Unix seconds
+	EndTs    int64  `json:"endTs,omitempty" url:"endTs,omitempty"`       // Unix seconds
+	Fidelity int    `json:"fidelity,omitempty" url:"fidelity,omitempty"` // Resolution in minutes
+}
+
+// PricePoint is a single point of a price time series
+type PricePoint struct {
+	Time  time.Time `json:"t"`
+	Price Decimal   `json:"p"`
+}
+
+// UnmarshalJSON decodes a price point from {"t": <unix seconds>, "p": <price>}
+func (p *PricePoint) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		T int64   `json:"t"`
+		P Decimal `json:"p"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	p.Time = time.Unix(raw.T, 0).UTC()
+	p.Price = raw.P
+	return nil
+}
+
+// MarshalJSON encodes a price point in the same shape the API uses
+func (p PricePoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		T int64   `json:"t"`
+		P Decimal `json:"p"`
+	}{p.Time.Unix(), p.Price})
+}
+
+// OutcomePriceHistory is the price history of one outcome of a market
+type OutcomePriceHistory struct {
+	Outcome Outcome
+	History []PricePoint
+}
+
+// Position represents a user's open position in a market outcome (Data API)
+type Position struct {
+	ProxyWallet        string  `json:"proxyWallet"`
+	Asset              string  `json:"asset"` // Token ID
+	ConditionID        string  `json:"conditionId"`
+	Size               Decimal `json:"size"`
+	AvgPrice           Decimal `json:"avgPrice"`
+	InitialValue       Decimal `json:"initialValue"`
+	CurrentValue       Decimal `json:"currentValue"`
+	CashPnl            Decimal `json:"cashPnl"`
+	PercentPnl         Decimal `json:"percentPnl"`
+	TotalBought        Decimal `json:"totalBought"`
+	RealizedPnl        Decimal `json:"realizedPnl"`
+	PercentRealizedPnl Decimal `json:"percentRealizedPnl"`
+	CurPrice           Decimal `json:"curPrice"`
+	Redeemable         bool    `json:"redeemable"`
+	Mergeable          bool    `json:"mergeable"`
+	Title              string  `json:"title"`
+	Slug               string  `json:"slug"`
+	Icon               string  `json:"icon"`
+	EventSlug          string  `json:"eventSlug"`
+	Outcome            string  `json:"outcome"`
+	OutcomeIndex       int     `json:"outcomeIndex"`
+	OppositeOutcome    string  `json:"oppositeOutcome"`
+	OppositeAsset      string  `json:"oppositeAsset"`
+	EndDate            string  `json:"endDate"`
+	NegativeRisk       bool    `json:"negativeRisk"`
+}
+
+// ClosedPosition represents a user's closed position in a market outcome (Data API)
+type ClosedPosition struct {
+	ProxyWallet     string  `json:"proxyWallet"`
+	Asset           string  `json:"asset"` // Token ID
+	ConditionID     string  `json:"conditionId"`
+	AvgPrice        Decimal `json:"avgPrice"`
+	TotalBought     Decimal `json:"totalBought"`
+	RealizedPnl     Decimal `json:"realizedPnl"`
+	CurPrice        Decimal `json:"curPrice"`
+	Timestamp       int64   `json:"timestamp"` // Unix seconds
+	Title           string  `json:"title"`
+	Slug            string  `json:"slug"`
+	Icon            string  `json:"icon"`
+	EventSlug       string  `json:"eventSlug"`
+	Outcome         string  `json:"outcome"`
+	OutcomeIndex    int     `json:"outcomeIndex"`
+	OppositeOutcome string  `json:"oppositeOutcome"`
+	OppositeAsset   string  `json:"oppositeAsset"`
+	EndDate         string  `json:"endDate"`
+}
+
+// Trade represents a trade recorded by the Data API
+type Trade struct {
+	ProxyWallet     string  `json:"proxyWallet"`
+	Side            Side    `json:"side"`
+	Asset           string  `json:"asset"` // Token ID
+	ConditionID     string  `json:"conditionId"`
+	Size            Decimal `json:"size"`
+	Price           Decimal `json:"price"`
+	Timestamp       int64   `json:"timestamp"` // Unix seconds
+	Title           string  `json:"title"`
+	Slug            string  `json:"slug"`
+	Icon            string  `json:"icon"`
+	EventSlug       string  `json:"eventSlug"`
+	Outcome         string  `json:"outcome"`
+	OutcomeIndex    int     `json:"outcomeIndex"`
+	Name            string  `json:"name"`
+	Pseudonym       string  `json:"pseudonym"`
+	Bio             string  `json:"bio"`
+	ProfileImage    string  `json:"profileImage"`
+	TransactionHash string  `json:"transactionHash"`
+}
+
+// Activity types reported by the Data API
+const (
+	ActivityTrade      = "TRADE"
+	ActivitySplit      = "SPLIT"
+	ActivityMerge      = "MERGE"
+	ActivityRedeem     = "REDEEM"
+	ActivityReward     = "REWARD"
+	ActivityConversion = "CONVERSION"
+)
+
+// Activity represents an on-chain activity entry of a user (Data API)
+type Activity struct {
+	ProxyWallet     string  `json:"proxyWallet"`
+	Timestamp       int64   `json:"timestamp"` // Unix seconds
+	ConditionID     string  `json:"conditionId"`
+	Type            string  `json:"type"`
+	Size            Decimal `json:"size"`
+	UsdcSize        Decimal `json:"usdcSize"`
+	TransactionHash string  `json:"transactionHash"`
+	Price           Decimal `json:"price"`
+	Asset           string  `json:"asset"` // Token ID
+	Side            Side    `json:"side"`
+	OutcomeIndex    int     `json:"outcomeIndex"`
+	Title           string  `json:"title"`
+	Slug            string  `json:"slug"`
+	Icon            string  `json:"icon"`
+	EventSlug       string  `json:"eventSlug"`
+	Outcome         string  `json:"outcome"`
+	Name            string  `json:"name"`
+	Pseudonym       string  `json:"pseudonym"`
+	Bio             string  `json:"bio"`
+	ProfileImage    string  `json:"profileImage"`
+}
+
+// PortfolioValue is the total value of a user's positions (Data API)
+type PortfolioValue struct {
+	User  string  `json:"user"`
+	Value Decimal `json:"value"`
+}
+
+// Holder is a holder of a market outcome token (Data API)
+type Holder struct {
+	ProxyWallet           string  `json:"proxyWallet"`
+	Asset                 string  `json:"asset"` // Token ID
+	Amount                Decimal `json:"amount"`
+	OutcomeIndex          int     `json:"outcomeIndex"`
+	Name                  string  `json:"name"`
+	Pseudonym             string  `json:"pseudonym"`
+	Bio                   string  `json:"bio"`
+	ProfileImage          string  `json:"profileImage"`
+	DisplayUsernamePublic bool    `json:"displayUsernamePublic"`
+}
+
+// TokenHolders lists the top holders of a single token (Data API)
+type TokenHolders struct {
+	Token   string   `json:"token"`
+	Holders []Holder `json:"holders"`
+}
+
+// OpenInterest is the open interest of a market (Data API)
+type OpenInterest struct {
+	Market string  `json:"market"` // Condition ID
+	Value  Decimal `json:"value"`
+}
+
+// Sort directions accepted by Data API params
+const (
+	SortAsc  = "ASC"
+	SortDesc = "DESC"
+)
+
+// PositionsParams represents query parameters for a user's positions
+type PositionsParams struct {
+	// Required
+	User string `json:"user" url:"user"` // Wallet address
+
+	// Pagination
+	Limit  int `json:"limit,omitempty" url:"limit,omitempty"`
+	Offset int `json:"offset,omitempty" url:"offset,omitempty"`
+
+	// Sorting
+	SortBy        string `json:"sortBy,omitempty" url:"sortBy,omitempty"` // CURRENT, INITIAL, TOKENS, CASHPNL, PERCENTPNL, TITLE, RESOLVING, PRICE, AVGPRICE
+	SortDirection string `json:"sortDirection,omitempty" url:"sortDirection,omitempty"`
+
+	// Filters
+	Market        []string `json:"market,omitempty" url:"market,omitempty,comma"` // Condition IDs
+	EventID       []int    `json:"eventId,omitempty" url:"eventId,omitempty,comma"`
+	SizeThreshold *Decimal `json:"sizeThreshold,omitempty" url:"sizeThreshold,omitempty"`
+	Redeemable    *bool    `json:"redeemable,omitempty" url:"redeemable,omitempty"`
+	Mergeable     *bool    `json:"mergeable,omitempty" url:"mergeable,omitempty"`
+	Title         string   `json:"title,omitempty" url:"title,omitempty"`
+}
+
+// ClosedPositionsParams represents query parameters for a user's closed positions
+type ClosedPositionsParams struct {
+	// Required
+	User string `json:"user" url:"user"` // Wallet address
+
+	// Pagination
+	Limit  int `json:"limit,omitempty" url:"limit,omitempty"`
+	Offset int `json:"offset,omitempty" url:"offset,omitempty"`
+
+	// Sorting
+	SortBy        string `json:"sortBy,omitempty" url:"sortBy,omitempty"` // REALIZEDPNL, TITLE, PRICE, AVGPRICE, TIMESTAMP
+	SortDirection string `json:"sortDirection,omitempty" url:"sortDirection,omitempty"`
+
+	// Filters
+	Market  []string `json:"market,omitempty" url:"market,omitempty,comma"` // Condition IDs
+	EventID []int    `json:"eventId,omitempty" url:"eventId,omitempty,comma"`
+	Title   string   `json:"title,omitempty" url:"title,omitempty"`
+}
+
+// TradesParams represents query parameters for listing trades
+type TradesParams struct {
+	// Pagination
+	Limit  int `json:"limit,omitempty" url:"limit,omitempty"`
+	Offset int `json:"offset,omitempty" url:"offset,omitempty"`
+
+	// Filters
+	User         string   `json:"user,omitempty" url:"user,omitempty"`           // Wallet address
+	Market       []string `json:"market,omitempty" url:"market,omitempty,comma"` // Condition IDs
+	EventID      []int    `json:"eventId,omitempty" url:"eventId,omitempty,comma"`
+	Side         Side     `json:"side,omitempty" url:"side,omitempty"`
+	TakerOnly    *bool    `json:"takerOnly,omitempty" url:"takerOnly,omitempty"`
+	FilterType   string   `json:"filterType,omitempty" url:"filterType,omitempty"` // CASH or TOKENS
+	FilterAmount *Decimal `json:"filterAmount,omitempty" url:"filterAmount,omitempty"`
 }
 
-// APIError represents an error response from the Polymarket API
-type APIError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+// ActivityParams represents query parameters for a user's activity
+type ActivityParams struct {
+	// Required
+	User string `json:"user" url:"user"` // Wallet address
+
+	// Pagination
+	Limit  int `json:"limit,omitempty" url:"limit,omitempty"`
+	Offset int `json:"offset,omitempty" url:"offset,omitempty"`
+
+	// Sorting
+	SortBy        string `json:"sortBy,omitempty" url:"sortBy,omitempty"` // TIMESTAMP, TOKENS, CASH
+	SortDirection string `json:"sortDirection,omitempty" url:"sortDirection,omitempty"`
+
+	// Filters
+	Market  []string `json:"market,omitempty" url:"market,omitempty,comma"` // Condition IDs
+	EventID []int    `json:"eventId,omitempty" url:"eventId,omitempty,comma"`
+	Type    []string `json:"type,omitempty" url:"type,omitempty,comma"` // Activity* constants
+	Side    Side     `json:"side,omitempty" url:"side,omitempty"`
+	Start   int64    `json:"start,omitempty" url:"start,omitempty"` // Unix seconds
+	End     int64    `json:"end,omitempty" url:"end,omitempty"`     // Unix seconds
 }
 
-func (e *APIError) Error() string {
-	return e.Message
+// HoldersParams represents query parameters for listing market holders
+type HoldersParams struct {
+	// Required
+	Market []string `json:"market" url:"market,omitempty,comma"` // Condition IDs
+
+	Limit      int      `json:"limit,omitempty" url:"limit,omitempty"`
+	MinBalance *Decimal `json:"minBalance,omitempty" url:"minBalance,omitempty"`
 }
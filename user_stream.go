@@ -0,0 +1,194 @@
+package polymarket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// User channel event types
+const (
+	UserEventOrder = "order"
+	UserEventTrade = "trade"
+)
+
+// Order event kinds reported in OrderEvent.Type
+const (
+	OrderEventPlacement    = "PLACEMENT"
+	OrderEventUpdate       = "UPDATE"
+	OrderEventCancellation = "CANCELLATION"
+)
+
+// UserEvent is an event received on the authenticated CLOB user channel.
+// It is one of *OrderEvent or *TradeEvent.
+type UserEvent interface {
+	EventType() string
+}
+
+// OrderEvent reports the placement, partial fill or cancellation of one of the user's orders
+type OrderEvent struct {
+	OpenOrder
+	Type       string `json:"type"` // OrderEventPlacement, OrderEventUpdate or OrderEventCancellation
+	OrderOwner string `json:"order_owner"`
+	Timestamp  string `json:"timestamp"` // Unix milliseconds
+}
+
+// EventType returns UserEventOrder
+func (e *OrderEvent) EventType() string { return UserEventOrder }
+
+// TradeEvent reports a trade involving the user's orders and its settlement status
+type TradeEvent struct {
+	UserTrade
+	TradeOwner string `json:"trade_owner"`
+	Timestamp  string `json:"timestamp"` // Unix milliseconds
+}
+
+// EventType returns UserEventTrade
+func (e *TradeEvent) EventType() string { return UserEventTrade }
+
+// UserStream is a live subscription to the authenticated CLOB user channel.
+// It reconnects and re-authenticates automatically.
+type UserStream struct {
+	session *wsSession
+	events  chan UserEvent
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// SubscribeUser connects to the CLOB user channel and streams order and trade
+// events of the authenticated user for the given markets (condition IDs).
+// No markets subscribes to all of them. It requires WithL2Auth.
+func (c *Client) SubscribeUser(markets []string, opts *StreamOptions) (*UserStream, error) {
+	return c.SubscribeUserContext(context.Background(), markets, opts)
+}
+
+// SubscribeUserContext is like SubscribeUser but uses ctx for the
+// initial connection and the lifetime of the stream
+func (c *Client) SubscribeUserContext(ctx context.Context, markets []string, opts *StreamOptions) (*UserStream, error) {
+	if err := c.requireCredentials(); err != nil {
+		return nil, err
+	}
+	if markets == nil {
+		markets = []string{}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &UserStream{
+		session: c.newWSSession(c.wsBaseURL+"/user", opts),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	s.events = make(chan UserEvent, s.session.opts.BufferSize)
+
+	creds := *c.credentials
+	s.session.subscribe = func() interface{} {
+		return map[string]interface{}{
+			"auth": map[string]string{
+				"apiKey":     creds.Key,
+				"secret":     creds.Secret,
+				"passphrase": creds.Passphrase,
+			},
+			"markets": markets,
+			"type":    "user",
+		}
+	}
+	s.session.onMessage = func(data []byte) {
+		s.handleMessage(ctx, data)
+	}
+
+	conn, err := s.session.dial(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe to user channel: %w", err)
+	}
+
+	go func() {
+		err := s.session.run(ctx, conn)
+
+		s.mu.Lock()
+		if !errors.Is(err, context.Canceled) {
+			s.err = err
+		}
+		s.mu.Unlock()
+
+		close(s.events)
+		close(s.done)
+	}()
+
+	return s, nil
+}
+
+// Events returns the channel of user events. It is closed when the stream ends.
+func (s *UserStream) Events() <-chan UserEvent {
+	return s.events
+}
+
+// Close ends the stream and waits for it to shut down
+func (s *UserStream) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// Err returns the error that ended the stream, or nil if it was closed
+func (s *UserStream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// handleMessage decodes a channel message and delivers its events
+func (s *UserStream) handleMessage(ctx context.Context, data []byte) {
+	messages, err := splitMessages(data)
+	if err != nil {
+		s.session.client.logDebug("polymarket user stream: invalid message", "error", err)
+		return
+	}
+
+	for _, message := range messages {
+		event, err := decodeUserEvent(message)
+		if err != nil {
+			s.session.client.logDebug("polymarket user stream: invalid event", "error", err)
+			continue
+		}
+		if event == nil {
+			continue
+		}
+
+		select {
+		case s.events <- event:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// decodeUserEvent decodes a single user channel event. Unknown event types
+// decode to nil.
+func decodeUserEvent(data []byte) (UserEvent, error) {
+	var header struct {
+		EventType string `json:"event_type"`
+	}
+	if err := decodeJSON(data, &header); err != nil {
+		return nil, err
+	}
+
+	var event UserEvent
+	switch header.EventType {
+	case UserEventOrder:
+		event = &OrderEvent{}
+	case UserEventTrade:
+		event = &TradeEvent{}
+	default:
+		return nil, nil
+	}
+
+	if err := decodeJSON(data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
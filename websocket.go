@@ -0,0 +1,264 @@
+package polymarket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// CLOBWebSocketURL is the base URL of the CLOB WebSocket channels
+	CLOBWebSocketURL = "wss://ws-subscriptions-clob.polymarket.com/ws"
+
+	// DefaultStreamPingInterval is how often streams send an application-level PING
+	DefaultStreamPingInterval = 10 * time.Second
+
+	// DefaultStreamBufferSize is the capacity of a stream's event channel
+	DefaultStreamBufferSize = 256
+)
+
+// errNotConnected is returned when writing to a stream between connections
+var errNotConnected = errors.New("websocket not connected")
+
+// StreamOptions controls a WebSocket stream. A nil *StreamOptions uses the defaults.
+type StreamOptions struct {
+	// PingInterval is how often a PING message is sent to keep the
+	// connection alive (default 10s). The connection is considered dead
+	// when nothing is received for three intervals.
+	PingInterval time.Duration
+
+	// ReconnectMinBackoff and ReconnectMaxBackoff bound the delay between
+	// reconnection attempts (defaults 500ms and 30s)
+	ReconnectMinBackoff time.Duration
+	ReconnectMaxBackoff time.Duration
+
+	// DisableReconnect makes the stream end on the first connection error
+	DisableReconnect bool
+
+	// BufferSize is the capacity of the event channel (default 256)
+	BufferSize int
+}
+
+// withDefaults returns a copy of the options with zero fields defaulted
+func (o *StreamOptions) withDefaults() StreamOptions {
+	opts := StreamOptions{}
+	if o != nil {
+		opts = *o
+	}
+	if opts.PingInterval <= 0 {
+		opts.PingInterval = DefaultStreamPingInterval
+	}
+	if opts.ReconnectMinBackoff <= 0 {
+		opts.ReconnectMinBackoff = 500 * time.Millisecond
+	}
+	if opts.ReconnectMaxBackoff < opts.ReconnectMinBackoff {
+		opts.ReconnectMaxBackoff = 30 * time.Second
+	}
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = DefaultStreamBufferSize
+	}
+	return opts
+}
+
+// wsSession keeps a WebSocket connection alive: it sends heartbeats,
+// reconnects with backoff and replays the subscription on every new connection.
+type wsSession struct {
+	url    string
+	header http.Header
+	dialer *websocket.Dialer
+	opts   StreamOptions
+	client *Client
+
+	// subscribe returns the message sent after each (re)connection
+	subscribe func() interface{}
+
+	// onConnect is called after each successful (re)connection and subscription
+	onConnect func()
+
+	// onMessage is called for every data message, from the read goroutine
+	onMessage func(data []byte)
+
+	writeMu sync.Mutex
+	conn    *websocket.Conn
+}
+
+// newWSSession creates a session for the given URL using the client's HTTP settings
+func (c *Client) newWSSession(wsURL string, opts *StreamOptions) *wsSession {
+	header := http.Header{}
+	header.Set("User-Agent", c.userAgent)
+	for key, values := range c.headers {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+
+	return &wsSession{
+		url:    wsURL,
+		header: header,
+		dialer: &websocket.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			HandshakeTimeout: c.httpClient.Timeout,
+		},
+		opts:   opts.withDefaults(),
+		client: c,
+	}
+}
+
+// dial opens a connection and sends the subscription message
+func (s *wsSession) dial(ctx context.Context) (*websocket.Conn, error) {
+	conn, _, err := s.dialer.DialContext(ctx, s.url, s.header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", s.url, err)
+	}
+
+	// Subscribe before publishing the connection, so that subscription
+	// changes made concurrently are never sent ahead of the subscription
+	s.writeMu.Lock()
+	if s.subscribe != nil {
+		if err := conn.WriteJSON(s.subscribe()); err != nil {
+			s.writeMu.Unlock()
+			conn.Close()
+			return nil, fmt.Errorf("failed to subscribe: %w", err)
+		}
+	}
+	s.conn = conn
+	s.writeMu.Unlock()
+	if s.onConnect != nil {
+		s.onConnect()
+	}
+
+	s.client.logDebug("polymarket stream connected", "url", s.url)
+	return conn, nil
+}
+
+// run serves conn and reconnects until ctx is done or reconnection is
+// disabled. It returns the error that ended the session.
+func (s *wsSession) run(ctx context.Context, conn *websocket.Conn) error {
+	backoff := s.opts.ReconnectMinBackoff
+	for {
+		var err error
+		if conn != nil {
+			err = s.serve(ctx, conn)
+			conn.Close()
+
+			s.writeMu.Lock()
+			s.conn = nil
+			s.writeMu.Unlock()
+
+			backoff = s.opts.ReconnectMinBackoff
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if s.opts.DisableReconnect {
+			return err
+		}
+
+		s.client.logDebug("polymarket stream reconnecting", "url", s.url, "wait", backoff, "error", err)
+		if err := sleepContext(ctx, backoff); err != nil {
+			return err
+		}
+		backoff *= 2
+		if backoff > s.opts.ReconnectMaxBackoff {
+			backoff = s.opts.ReconnectMaxBackoff
+		}
+
+		conn, err = s.dial(ctx)
+		if err != nil {
+			conn = nil
+			s.client.logDebug("polymarket stream connect failed", "url", s.url, "error", err)
+		}
+	}
+}
+
+// serve reads messages from conn until it fails or ctx is done
+func (s *wsSession) serve(ctx context.Context, conn *websocket.Conn) error {
+	done := make(chan struct{})
+	defer close(done)
+
+	// Heartbeat, and unblock the reader when ctx is done
+	go func() {
+		ticker := time.NewTicker(s.opts.PingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				conn.Close()
+				return
+			case <-ticker.C:
+				if err := s.writeText("PING"); err != nil {
+					conn.Close()
+					return
+				}
+			}
+		}
+	}()
+
+	readTimeout := 3 * s.opts.PingInterval
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(readTimeout))
+	})
+
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(readTimeout)); err != nil {
+			return err
+		}
+
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		if string(data) == "PONG" {
+			continue
+		}
+		s.onMessage(data)
+	}
+}
+
+// writeJSON sends v on the current connection
+func (s *wsSession) writeJSON(v interface{}) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if s.conn == nil {
+		return errNotConnected
+	}
+	return s.conn.WriteJSON(v)
+}
+
+// writeText sends a text message on the current connection
+func (s *wsSession) writeText(text string) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if s.conn == nil {
+		return errNotConnected
+	}
+	return s.conn.WriteMessage(websocket.TextMessage, []byte(text))
+}
+
+// splitMessages splits a channel message, which may hold a single event
+// object or an array of events, into individual events
+func splitMessages(data []byte) ([]json.RawMessage, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return []json.RawMessage{trimmed}, nil
+	}
+
+	var events []json.RawMessage
+	if err := decodeJSON(trimmed, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}